@@ -0,0 +1,111 @@
+package personnummer
+
+import "time"
+
+// adultAge is the age of majority in Sweden.
+const adultAge = 18
+
+// Age represents a calendar age broken down in years, months and days.
+type Age struct {
+	Years  int
+	Months int
+	Days   int
+}
+
+// daysIn returns the number of days in the given month.
+func daysIn(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// anniversary returns the date in the given year and month that the given day
+// falls on. Days that do not exist in the month fall on the first day of the
+// next month, so people born on 29 February have their birthday on 1 March
+// in years that are not leap years, as is the legal convention in Sweden.
+func anniversary(year int, month time.Month, day int) time.Time {
+	if day > daysIn(year, month) {
+		return time.Date(year, month+1, 1, 0, 0, 0, 0, time.UTC)
+	}
+
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// calendarDate returns the calendar date of t in its own location as a UTC time.
+func calendarDate(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// GetAge returns the age from a Swedish personal identity number
+// as of the reference date it was parsed with.
+func (p *Personnummer) GetAge() int {
+	if p.options.ReferenceDate.IsZero() {
+		return p.AgeAt(now())
+	}
+
+	return p.AgeAt(p.options.ReferenceDate)
+}
+
+// AgeAt returns the age in whole years from a Swedish personal identity number
+// on the calendar date of the given time, in the time's own location.
+func (p *Personnummer) AgeAt(t time.Time) int {
+	birth := p.GetDate()
+	date := calendarDate(t)
+
+	years := date.Year() - birth.Year()
+	if date.Before(anniversary(date.Year(), birth.Month(), birth.Day())) {
+		years--
+	}
+
+	return years
+}
+
+// AgeOn returns the age in whole years from a Swedish personal identity number on the given date.
+func (p *Personnummer) AgeOn(year int, month time.Month, day int) int {
+	return p.AgeAt(time.Date(year, month, day, 0, 0, 0, 0, time.UTC))
+}
+
+// AgeDetailsAt returns the age from a Swedish personal identity number
+// in years, months and days on the calendar date of the given time.
+// The zero Age is returned for times before the date of birth.
+func (p *Personnummer) AgeDetailsAt(t time.Time) Age {
+	birth := p.GetDate()
+	date := calendarDate(t)
+
+	if date.Before(birth) {
+		return Age{}
+	}
+
+	age := Age{Years: p.AgeAt(t)}
+
+	last := anniversary(birth.Year()+age.Years, birth.Month(), birth.Day())
+	for {
+		next := anniversary(last.Year(), birth.Month()+time.Month(age.Months+1), birth.Day())
+		if next.After(date) {
+			break
+		}
+		age.Months++
+	}
+
+	from := anniversary(last.Year(), birth.Month()+time.Month(age.Months), birth.Day())
+	age.Days = int(date.Sub(from).Hours() / 24)
+
+	return age
+}
+
+// IsAdultAt checks if the person is 18 years or older at the given time.
+func (p *Personnummer) IsAdultAt(t time.Time) bool {
+	return p.AgeAt(t) >= adultAge
+}
+
+// NextBirthday returns the first birthday on or after the calendar date of the given time.
+func (p *Personnummer) NextBirthday(t time.Time) time.Time {
+	birth := p.GetDate()
+	date := calendarDate(t)
+
+	next := anniversary(date.Year(), birth.Month(), birth.Day())
+	if next.Before(date) {
+		next = anniversary(date.Year()+1, birth.Month(), birth.Day())
+	}
+
+	return next
+}
@@ -0,0 +1,47 @@
+package personnummer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/frozzare/go-assert"
+)
+
+func TestAgeAt(t *testing.T) {
+	p, _ := Parse("19850709-9805")
+
+	assert.Equal(t, 40, p.AgeAt(time.Date(2026, 7, 8, 23, 59, 0, 0, time.UTC)))
+	assert.Equal(t, 41, p.AgeAt(time.Date(2026, 7, 9, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, 41, p.AgeOn(2026, time.July, 9))
+	assert.Equal(t, 0, p.AgeOn(1985, time.July, 9))
+
+	// The calendar date is taken in the location of the given time.
+	stockholm := time.FixedZone("CEST", 2*60*60)
+	assert.Equal(t, 41, p.AgeAt(time.Date(2026, 7, 9, 0, 30, 0, 0, stockholm)))
+	assert.Equal(t, 40, p.AgeAt(time.Date(2026, 7, 9, 0, 30, 0, 0, stockholm).UTC()))
+}
+
+func TestAgeLeapDay(t *testing.T) {
+	p, _ := Parse("20040229-1231")
+
+	assert.Equal(t, 21, p.AgeOn(2026, time.February, 28))
+	assert.Equal(t, 22, p.AgeOn(2026, time.March, 1))
+	assert.Equal(t, 23, p.AgeOn(2027, time.March, 1))
+	assert.Equal(t, 24, p.AgeOn(2028, time.February, 29))
+
+	assert.False(t, p.IsAdultAt(time.Date(2022, 2, 28, 0, 0, 0, 0, time.UTC)))
+	assert.True(t, p.IsAdultAt(time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)))
+
+	assert.Equal(t, time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), p.NextBirthday(time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC), p.NextBirthday(time.Date(2027, 3, 2, 0, 0, 0, 0, time.UTC)))
+}
+
+func TestAgeDetailsAt(t *testing.T) {
+	p, _ := Parse("19850709-9805")
+
+	assert.Equal(t, Age{Years: 41, Months: 3, Days: 8}, p.AgeDetailsAt(time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, Age{Years: 40, Months: 11, Days: 29}, p.AgeDetailsAt(time.Date(2026, 7, 8, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, Age{}, p.AgeDetailsAt(time.Date(1980, 1, 1, 0, 0, 0, 0, time.UTC)))
+
+	assert.Equal(t, time.Date(2026, 7, 9, 0, 0, 0, 0, time.UTC), p.NextBirthday(time.Date(2026, 7, 9, 12, 0, 0, 0, time.UTC)))
+}
@@ -0,0 +1,132 @@
+package personnummer
+
+import (
+	"fmt"
+	"strings"
+)
+
+// The packed encoding of a number, from the most significant bit: year,
+// month, day of birth, serial number, check digit, interim letter and
+// coordination flag. Encoded numbers sort by date of birth, then serial number.
+const (
+	coordinationBits = 1
+	interimBits      = 4
+	checkBits        = 4
+	serialBits       = 10
+	dayBits          = 5
+	monthBits        = 4
+	yearBits         = 14
+
+	interimShift = coordinationBits
+	checkShift   = interimShift + interimBits
+	serialShift  = checkShift + checkBits
+	dayShift     = serialShift + serialBits
+	monthShift   = dayShift + dayBits
+	yearShift    = monthShift + monthBits
+	encodedBits  = yearShift + yearBits
+
+	// binaryLength is the number of bytes of the binary encoding.
+	binaryLength = (encodedBits + 7) / 8
+)
+
+// Uint64 returns the number packed in the lower 42 bits of an uint64.
+// The values sort by date of birth, then serial number, check digit and kind.
+func (p *Personnummer) Uint64() uint64 {
+	day := charsToDigit([]byte(p.Day))
+	coordination := uint64(0)
+	if p.IsCoordinationNumber() {
+		day -= 60
+		coordination = 1
+	}
+
+	interim := uint64(0)
+	serial := p.Num
+	if p.IsInterimNumber() {
+		interim = uint64(strings.IndexByte(string(interimLetters), p.Num[0]) + 1)
+		serial = p.Num[1:]
+	}
+
+	return uint64(charsToDigit([]byte(p.FullYear)))<<yearShift |
+		uint64(charsToDigit([]byte(p.Month)))<<monthShift |
+		uint64(day)<<dayShift |
+		uint64(charsToDigit([]byte(serial)))<<serialShift |
+		uint64(charsToDigit([]byte(p.Check)))<<checkShift |
+		interim<<interimShift |
+		coordination
+}
+
+// FromUint64 returns the number packed in v by Uint64, validated like New.
+func FromUint64(v uint64, options ...*Options) (*Personnummer, error) {
+	if v>>encodedBits != 0 {
+		return nil, ErrInvalidEncoding
+	}
+
+	field := func(shift, bits int) int {
+		return int(v >> shift & (1<<bits - 1))
+	}
+
+	day := field(dayShift, dayBits)
+	if field(0, coordinationBits) == 1 {
+		day += 60
+	}
+
+	num := fmt.Sprintf("%03d", field(serialShift, serialBits))
+	if interim := field(interimShift, interimBits); interim > 0 {
+		if interim > len(interimLetters) || field(serialShift, serialBits) > 99 {
+			return nil, ErrInvalidEncoding
+		}
+
+		num = fmt.Sprintf("%c%02d", interimLetters[interim-1], field(serialShift, serialBits))
+	}
+
+	check := field(checkShift, checkBits)
+	if check > 9 {
+		return nil, ErrInvalidEncoding
+	}
+
+	return New(fmt.Sprintf("%04d%02d%02d%s%d", field(yearShift, yearBits), field(monthShift, monthBits), day, num, check), options...)
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface
+// with the big-endian bytes of Uint64. Numbers that cannot be
+// decoded by UnmarshalBinary return an error.
+func (p *Personnummer) MarshalBinary() ([]byte, error) {
+	v := p.Uint64()
+	if _, err := FromUint64(v, &Options{AllowInterimNumber: true}); err != nil {
+		return nil, err
+	}
+
+	b := make([]byte, binaryLength)
+
+	for i := range b {
+		b[i] = byte(v >> (8 * (binaryLength - 1 - i)))
+	}
+
+	return b, nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+// Interim numbers are accepted, the options the number was created with are kept.
+func (p *Personnummer) UnmarshalBinary(data []byte) error {
+	if len(data) != binaryLength {
+		return ErrInvalidEncoding
+	}
+
+	var v uint64
+	for _, b := range data {
+		v = v<<8 | uint64(b)
+	}
+
+	options := p.options
+	options.AllowInterimNumber = true
+
+	n, err := FromUint64(v, &options)
+	if err != nil {
+		return err
+	}
+
+	n.options = p.options
+	*p = *n
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package personnummer
+
+import (
+	"errors"
+	"sort"
+	"testing"
+
+	"github.com/frozzare/go-assert"
+)
+
+func TestUint64(t *testing.T) {
+	options := &Options{AllowInterimNumber: true}
+
+	for _, in := range []string{"198507099805", "198507699802", "19850709T224", "19850769T221", "20040229-1231", "18850709+9805", "20500101-1237", "99991231-1231"} {
+		p, err := Parse(in, options)
+		assert.Nil(t, err, in)
+
+		v := p.Uint64()
+		assert.True(t, v < 1<<42, in)
+
+		q, err := FromUint64(v, options)
+		assert.Nil(t, err, in)
+		assert.Equal(t, p.Kind(), q.Kind(), in)
+
+		a, _ := p.Format(true)
+		b, _ := q.Format(true)
+		assert.Equal(t, a, b)
+	}
+
+	p, _ := Parse("19850709T224", options)
+	_, err := FromUint64(p.Uint64())
+	assert.True(t, errors.Is(err, ErrInterimNumberNotAllowed))
+
+	_, err = FromUint64(p.Uint64() ^ 1<<checkShift)
+	assert.True(t, errors.Is(err, ErrInvalidChecksum))
+
+	_, err = FromUint64(1 << 42)
+	assert.True(t, errors.Is(err, ErrInvalidEncoding))
+}
+
+func TestUint64Order(t *testing.T) {
+	in := []string{"19850709-9805", "19850708-9806", "198507699802", "19850709-1232", "20040229-1231", "19000101-1220"}
+	sorted := []string{"190001011220", "198507089806", "198507091232", "198507699802", "198507099805", "200402291231"}
+
+	var list []*Personnummer
+	for _, v := range in {
+		p, err := Parse(v)
+		assert.Nil(t, err, v)
+		list = append(list, p)
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Uint64() < list[j].Uint64()
+	})
+
+	for i, p := range list {
+		v, _ := p.Format(true)
+		assert.Equal(t, sorted[i], v)
+	}
+}
+
+func TestMarshalBinary(t *testing.T) {
+	p, _ := Parse("850769-T221", &Options{AllowInterimNumber: true})
+
+	b, err := p.MarshalBinary()
+	assert.Nil(t, err)
+	assert.Equal(t, 6, len(b))
+
+	var q Personnummer
+	assert.Nil(t, q.UnmarshalBinary(b))
+	assert.Equal(t, KindInterimCoordination, q.Kind())
+
+	v, _ := q.Format(true)
+	assert.Equal(t, "19850769T221", v)
+
+	assert.True(t, errors.Is(q.UnmarshalBinary(b[:5]), ErrInvalidEncoding))
+
+	p, _ = Parse("20500101-1237")
+	b, err = p.MarshalBinary()
+	assert.Nil(t, err)
+	assert.Nil(t, q.UnmarshalBinary(b))
+	assert.True(t, p.Equal(&q))
+
+	_, err = (&Personnummer{}).MarshalBinary()
+	assert.NotNil(t, err)
+}
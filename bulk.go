@@ -0,0 +1,225 @@
+package personnummer
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// BulkOptions represents the options for validating many numbers.
+type BulkOptions struct {
+	// Workers is the number of goroutines validating numbers.
+	// The zero value means runtime.GOMAXPROCS(0).
+	Workers int
+
+	// Options are the options the numbers are parsed with.
+	Options *Options
+}
+
+// workers returns the number of workers to use.
+func (o *BulkOptions) workers() int {
+	if o.Workers > 0 {
+		return o.Workers
+	}
+
+	return runtime.GOMAXPROCS(0)
+}
+
+// Result is the result of validating one input.
+type Result struct {
+	// Index is the position of the input, counted from 0.
+	Index int
+
+	// Input is the validated string.
+	Input string
+
+	// Personnummer is the parsed number, nil when the input is invalid.
+	Personnummer *Personnummer
+
+	// Err is a *ParseError for invalid inputs, or the context
+	// error for inputs not validated before it was cancelled.
+	Err error
+}
+
+// Valid checks if the input was a valid number.
+func (r Result) Valid() bool {
+	return r.Err == nil
+}
+
+// validateOne validates the input unless the context is done.
+func validateOne(ctx context.Context, index int, input string, options *Options) Result {
+	r := Result{Index: index, Input: input}
+
+	if err := ctx.Err(); err != nil {
+		r.Err = err
+		return r
+	}
+
+	r.Personnummer, r.Err = New(input, options)
+
+	return r
+}
+
+// ValidateAll validates the inputs over a pool of workers and returns the
+// results in input order. Inputs not validated when the context is cancelled
+// get the context error.
+func ValidateAll(ctx context.Context, inputs []string, opts *BulkOptions) []Result {
+	if opts == nil {
+		opts = &BulkOptions{}
+	}
+
+	options := optionsOf(opts.Options)
+	results := make([]Result, len(inputs))
+
+	var next int64 = -1
+	var wg sync.WaitGroup
+
+	for w := 0; w < opts.workers(); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for {
+				i := int(atomic.AddInt64(&next, 1))
+				if i >= len(inputs) {
+					return
+				}
+
+				results[i] = validateOne(ctx, i, inputs[i], options)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	return results
+}
+
+// job is an input waiting for a worker, with the channel its result is sent on.
+type job struct {
+	index  int
+	input  string
+	result chan Result
+}
+
+// ValidateStream validates the inputs received from in over a pool of workers
+// and sends the results, in input order, on the returned channel. The channel
+// is closed when in is closed and all results are sent, or when the context is
+// cancelled, after which results not yet received are dropped.
+func ValidateStream(ctx context.Context, in <-chan string, opts *BulkOptions) <-chan Result {
+	if opts == nil {
+		opts = &BulkOptions{}
+	}
+
+	options := optionsOf(opts.Options)
+	workers := opts.workers()
+
+	jobs := make(chan job)
+	pending := make(chan chan Result, 2*workers)
+	out := make(chan Result)
+
+	go func() {
+		defer close(jobs)
+		defer close(pending)
+
+		for i := 0; ; i++ {
+			var input string
+			var ok bool
+
+			select {
+			case <-ctx.Done():
+				return
+			case input, ok = <-in:
+				if !ok {
+					return
+				}
+			}
+
+			j := job{index: i, input: input, result: make(chan Result, 1)}
+
+			select {
+			case <-ctx.Done():
+				return
+			case pending <- j.result:
+			}
+
+			select {
+			case <-ctx.Done():
+				j.result <- Result{Index: i, Input: input, Err: ctx.Err()}
+				return
+			case jobs <- j:
+			}
+		}
+	}()
+
+	for w := 0; w < workers; w++ {
+		go func() {
+			for j := range jobs {
+				j.result <- validateOne(ctx, j.index, j.input, options)
+			}
+		}()
+	}
+
+	go func() {
+		defer close(out)
+
+		for result := range pending {
+			r := <-result
+
+			select {
+			case <-ctx.Done():
+				return
+			case out <- r:
+			}
+		}
+	}()
+
+	return out
+}
+
+// Summary counts the results of validating many numbers.
+type Summary struct {
+	Total   int
+	Valid   int
+	Invalid int
+
+	// Errors counts the invalid inputs by reason, one of
+	// the Err variables or a context error.
+	Errors map[error]int
+}
+
+// Add counts a result.
+func (s *Summary) Add(r Result) {
+	s.Total++
+
+	if r.Err == nil {
+		s.Valid++
+		return
+	}
+
+	s.Invalid++
+
+	reason := r.Err
+	var pe *ParseError
+	if errors.As(r.Err, &pe) {
+		reason = pe.Err
+	}
+
+	if s.Errors == nil {
+		s.Errors = map[error]int{}
+	}
+	s.Errors[reason]++
+}
+
+// Summarize counts the results.
+func Summarize(results []Result) Summary {
+	var s Summary
+
+	for _, r := range results {
+		s.Add(r)
+	}
+
+	return s
+}
@@ -0,0 +1,109 @@
+package personnummer
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/frozzare/go-assert"
+)
+
+var bulkInputs = []string{
+	"850709-9805",
+	"850709-9806",
+	"198507099805",
+	"850709-1232",
+	"851309-1232",
+	"850769-9802",
+	"850709-T224",
+	"foo",
+}
+
+func TestValidateAll(t *testing.T) {
+	var inputs []string
+	for i := 0; i < 100; i++ {
+		inputs = append(inputs, bulkInputs...)
+	}
+
+	results := ValidateAll(context.Background(), inputs, &BulkOptions{Workers: 4})
+	assert.Equal(t, len(inputs), len(results))
+
+	for i, r := range results {
+		assert.Equal(t, i, r.Index)
+		assert.Equal(t, inputs[i], r.Input)
+		assert.Equal(t, Valid(inputs[i]), r.Valid(), inputs[i])
+		assert.Equal(t, r.Valid(), r.Personnummer != nil, inputs[i])
+	}
+
+	s := Summarize(results)
+	assert.Equal(t, 800, s.Total)
+	assert.Equal(t, 400, s.Valid)
+	assert.Equal(t, 400, s.Invalid)
+	assert.Equal(t, 100, s.Errors[ErrInvalidChecksum])
+	assert.Equal(t, 100, s.Errors[ErrInvalidMonth])
+	assert.Equal(t, 100, s.Errors[ErrInterimNumberNotAllowed])
+	assert.Equal(t, 100, s.Errors[ErrInvalidCharacter])
+}
+
+func TestValidateAllOptions(t *testing.T) {
+	results := ValidateAll(context.Background(), bulkInputs, &BulkOptions{
+		Options: &Options{AllowInterimNumber: true},
+	})
+
+	assert.True(t, results[6].Valid())
+	assert.Equal(t, 5, Summarize(results).Valid)
+}
+
+func TestValidateAllCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	results := ValidateAll(ctx, bulkInputs, nil)
+	assert.Equal(t, len(bulkInputs), len(results))
+
+	for _, r := range results {
+		assert.True(t, errors.Is(r.Err, context.Canceled))
+	}
+
+	assert.Equal(t, len(bulkInputs), Summarize(results).Errors[context.Canceled])
+}
+
+func TestValidateStream(t *testing.T) {
+	in := make(chan string)
+	go func() {
+		defer close(in)
+		for i := 0; i < 100; i++ {
+			for _, s := range bulkInputs {
+				in <- s
+			}
+		}
+	}()
+
+	var s Summary
+	i := 0
+	for r := range ValidateStream(context.Background(), in, &BulkOptions{Workers: 3}) {
+		assert.Equal(t, i, r.Index)
+		assert.Equal(t, bulkInputs[i%len(bulkInputs)], r.Input)
+		s.Add(r)
+		i++
+	}
+
+	assert.Equal(t, 800, s.Total)
+	assert.Equal(t, 400, s.Valid)
+}
+
+func TestValidateStreamCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	in := make(chan string)
+	out := ValidateStream(ctx, in, nil)
+
+	in <- "850709-9805"
+	r := <-out
+	assert.True(t, r.Valid())
+
+	cancel()
+
+	for r := range out {
+		assert.True(t, errors.Is(r.Err, context.Canceled))
+	}
+}
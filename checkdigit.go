@@ -0,0 +1,42 @@
+package personnummer
+
+// CheckDigit returns the check digit, as an ASCII digit, for a Swedish personal
+// identity number without it, that is with 9 or 11 digits. Separators are
+// ignored and the interim letter is replaced by 1 like when validating.
+func CheckDigit(partial string) (byte, error) {
+	digits, offset := getCleanNumber(partial)
+	if offset >= 0 {
+		return 0, &ParseError{Input: partial, Offset: offset, Err: ErrInvalidCharacter}
+	}
+
+	if len(digits) != lengthWithoutCentury-1 && len(digits) != lengthWithCentury-1 {
+		return 0, &ParseError{Input: partial, Offset: -1, Err: ErrInvalidLength}
+	}
+
+	serial := len(digits) - 3
+	for i, c := range digits {
+		if isDigit(c) {
+			continue
+		}
+
+		// Only the first digit of the serial number can be an interim letter.
+		if i != serial {
+			return 0, &ParseError{Input: partial, Offset: inputOffset(partial, i), Err: ErrInvalidCharacter}
+		}
+
+		digits[i] = '1'
+	}
+
+	return luhnCheckDigit(digits[len(digits)-9:]), nil
+}
+
+// Complete parses a Swedish personal identity number without the check digit,
+// see CheckDigit, and returns it with the check digit added.
+func Complete(partial string, options ...*Options) (*Personnummer, error) {
+	c, err := CheckDigit(partial)
+	if err != nil {
+		return nil, err
+	}
+
+	return New(partial+string(c), options...)
+}
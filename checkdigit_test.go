@@ -0,0 +1,70 @@
+package personnummer
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/frozzare/go-assert"
+)
+
+func TestCheckDigit(t *testing.T) {
+	tests := map[string]byte{
+		"850709980":     '5',
+		"850709-980":    '5',
+		"19850709980":   '5',
+		"19850709-980":  '5',
+		"850769-980":    '2',
+		"850709-T22":    '4',
+		"19850769-T22":  '1',
+		"040229-123":    '1',
+		"20040229-123":  '1',
+		"850709+980":    '5',
+		"18850709+980":  '5',
+		"000101-122":    '0',
+		"20000101-122":  '0',
+		"121212-121":    '2',
+		"19121212-121":  '2',
+		"19121212-1-21": '2',
+	}
+
+	for in, expected := range tests {
+		c, err := CheckDigit(in)
+		assert.Nil(t, err, in)
+		assert.Equal(t, expected, c, in)
+	}
+}
+
+func TestCheckDigitErrors(t *testing.T) {
+	_, err := CheckDigit("8507099")
+	assert.True(t, errors.Is(err, ErrInvalidLength))
+
+	_, err = CheckDigit("8507099805")
+	assert.True(t, errors.Is(err, ErrInvalidLength))
+
+	_, err = CheckDigit("8507a9-980")
+	assert.True(t, errors.Is(err, ErrInvalidCharacter))
+
+	var perr *ParseError
+	_, err = CheckDigit("1T850709-980")
+	assert.True(t, errors.As(err, &perr))
+	assert.Equal(t, ErrInvalidCharacter, perr.Err)
+	assert.Equal(t, 1, perr.Offset)
+}
+
+func TestComplete(t *testing.T) {
+	p, err := Complete("850709-980")
+	assert.Nil(t, err)
+	v, _ := p.Format()
+	assert.Equal(t, "850709-9805", v)
+
+	p, err = Complete("19850709-T22", &Options{AllowInterimNumber: true})
+	assert.Nil(t, err)
+	v, _ = p.Format(true)
+	assert.Equal(t, "19850709T224", v)
+
+	_, err = Complete("19850709-T22")
+	assert.True(t, errors.Is(err, ErrInterimNumberNotAllowed))
+
+	_, err = Complete("851309-980")
+	assert.True(t, errors.Is(err, ErrInvalidMonth))
+}
@@ -0,0 +1,40 @@
+package personnummer
+
+// Key is a comparable value identifying a Swedish personal identity number,
+// equal for all textual forms of the same number. Keys can be used as map keys.
+type Key uint64
+
+// Key returns the key of the number.
+func (p *Personnummer) Key() Key {
+	return Key(p.Uint64())
+}
+
+// Equal checks if two numbers are the same number, regardless of how they were written.
+func (p *Personnummer) Equal(q *Personnummer) bool {
+	return Compare(p, q) == 0
+}
+
+// Compare returns -1, 0 or 1 if a is less than, equal to or greater than b,
+// ordering by date of birth, serial number and check digit. Nil is less than
+// any number. Compare can be used with slices.SortFunc.
+func Compare(a, b *Personnummer) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return -1
+	case b == nil:
+		return 1
+	}
+
+	x, y := a.Uint64(), b.Uint64()
+
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	default:
+		return 0
+	}
+}
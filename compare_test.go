@@ -0,0 +1,45 @@
+package personnummer
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/frozzare/go-assert"
+)
+
+func TestEqual(t *testing.T) {
+	a, _ := Parse("8507099805")
+	b, _ := Parse("19850709-9805")
+	c, _ := Parse("850709-1232")
+
+	assert.True(t, a.Equal(b))
+	assert.False(t, a.Equal(c))
+	assert.False(t, a.Equal(nil))
+	assert.Equal(t, a.Key(), b.Key())
+	assert.NotEqual(t, a.Key(), c.Key())
+
+	seen := map[Key]bool{a.Key(): true}
+	assert.True(t, seen[b.Key()])
+}
+
+func TestCompare(t *testing.T) {
+	a, _ := Parse("19850708-9806")
+	b, _ := Parse("850709-1232")
+	c, _ := Parse("850769-9802")
+	d, _ := Parse("198507099805")
+
+	assert.Equal(t, 0, Compare(a, a))
+	assert.Equal(t, -1, Compare(a, b))
+	assert.Equal(t, 1, Compare(b, a))
+	assert.Equal(t, -1, Compare(nil, a))
+	assert.Equal(t, 1, Compare(a, nil))
+	assert.Equal(t, 0, Compare(nil, nil))
+
+	list := []*Personnummer{d, c, nil, b, a}
+	sort.Slice(list, func(i, j int) bool {
+		return Compare(list[i], list[j]) < 0
+	})
+
+	assert.Nil(t, list[0])
+	assert.Equal(t, []*Personnummer{a, b, c, d}, list[1:])
+}
@@ -0,0 +1,135 @@
+package personnummer
+
+// birthCountyLastYear is the last year of birth for which the serial number
+// tells the county of birth registration.
+const birthCountyLastYear = 1989
+
+// County represents a historical county (län) of birth registration.
+type County int
+
+// The historical counties, before the mergers of 1968, 1997 and 1998.
+const (
+	CountyStockholmsStad County = iota + 1
+	CountyStockholm
+	CountyUppsala
+	CountySodermanland
+	CountyOstergotland
+	CountyJonkoping
+	CountyKronoberg
+	CountyKalmar
+	CountyGotland
+	CountyBlekinge
+	CountyKristianstad
+	CountyMalmohus
+	CountyHalland
+	CountyGoteborgOchBohus
+	CountyAlvsborg
+	CountySkaraborg
+	CountyVarmland
+	CountyOrebro
+	CountyVastmanland
+	CountyKopparberg
+	CountyGavleborg
+	CountyVasternorrland
+	CountyJamtland
+	CountyVasterbotten
+	CountyNorrbotten
+
+	// CountyBornAbroad is used for people born abroad and
+	// foreign citizens born in Sweden before 1947.
+	CountyBornAbroad
+)
+
+// countyNames are the Swedish names of the counties.
+var countyNames = map[County]string{
+	CountyStockholmsStad:   "Stockholms stad",
+	CountyStockholm:        "Stockholms län",
+	CountyUppsala:          "Uppsala län",
+	CountySodermanland:     "Södermanlands län",
+	CountyOstergotland:     "Östergötlands län",
+	CountyJonkoping:        "Jönköpings län",
+	CountyKronoberg:        "Kronobergs län",
+	CountyKalmar:           "Kalmar län",
+	CountyGotland:          "Gotlands län",
+	CountyBlekinge:         "Blekinge län",
+	CountyKristianstad:     "Kristianstads län",
+	CountyMalmohus:         "Malmöhus län",
+	CountyHalland:          "Hallands län",
+	CountyGoteborgOchBohus: "Göteborgs och Bohus län",
+	CountyAlvsborg:         "Älvsborgs län",
+	CountySkaraborg:        "Skaraborgs län",
+	CountyVarmland:         "Värmlands län",
+	CountyOrebro:           "Örebro län",
+	CountyVastmanland:      "Västmanlands län",
+	CountyKopparberg:       "Kopparbergs län",
+	CountyGavleborg:        "Gävleborgs län",
+	CountyVasternorrland:   "Västernorrlands län",
+	CountyJamtland:         "Jämtlands län",
+	CountyVasterbotten:     "Västerbottens län",
+	CountyNorrbotten:       "Norrbottens län",
+	CountyBornAbroad:       "Född i utlandet",
+}
+
+// countyRanges maps the last of the first two serial digits of each county.
+// The numbers 65 and 74 were extra numbers not tied to a county.
+var countyRanges = []struct {
+	last   int
+	county County
+}{
+	{9, CountyStockholmsStad},
+	{13, CountyStockholm},
+	{15, CountyUppsala},
+	{18, CountySodermanland},
+	{23, CountyOstergotland},
+	{26, CountyJonkoping},
+	{28, CountyKronoberg},
+	{31, CountyKalmar},
+	{32, CountyGotland},
+	{34, CountyBlekinge},
+	{38, CountyKristianstad},
+	{45, CountyMalmohus},
+	{47, CountyHalland},
+	{54, CountyGoteborgOchBohus},
+	{58, CountyAlvsborg},
+	{61, CountySkaraborg},
+	{64, CountyVarmland},
+	{65, 0},
+	{68, CountyOrebro},
+	{70, CountyVastmanland},
+	{73, CountyKopparberg},
+	{74, 0},
+	{77, CountyGavleborg},
+	{81, CountyVasternorrland},
+	{84, CountyJamtland},
+	{88, CountyVasterbotten},
+	{92, CountyNorrbotten},
+	{99, CountyBornAbroad},
+}
+
+// String returns the Swedish name of the county.
+func (c County) String() string {
+	if name, ok := countyNames[c]; ok {
+		return name
+	}
+
+	return "Okänt"
+}
+
+// BirthCounty returns the county of birth registration, encoded in the first
+// two serial digits of numbers for people born before 1990. Returns false for
+// people born from 1990, for coordination and interim numbers and for the
+// extra numbers not tied to a county.
+func (p *Personnummer) BirthCounty() (County, bool) {
+	if len(p.Num) != 3 || charsToDigit([]byte(p.FullYear)) > birthCountyLastYear || p.Kind() != KindOrdinary {
+		return 0, false
+	}
+
+	n := charsToDigit([]byte(p.Num[0:2]))
+	for _, r := range countyRanges {
+		if n <= r.last {
+			return r.county, r.county != 0
+		}
+	}
+
+	return 0, false
+}
@@ -0,0 +1,39 @@
+package personnummer
+
+import (
+	"testing"
+
+	"github.com/frozzare/go-assert"
+)
+
+func TestBirthCounty(t *testing.T) {
+	tests := map[string]County{
+		"850709-0010": CountyStockholmsStad,
+		"850709-1232": CountyStockholm,
+		"850709-4830": CountyGoteborgOchBohus,
+		"850709-9805": CountyBornAbroad,
+	}
+
+	for in, expected := range tests {
+		p, err := Parse(in)
+		assert.Nil(t, err, in)
+
+		c, ok := p.BirthCounty()
+		assert.True(t, ok, in)
+		assert.Equal(t, expected, c, in)
+	}
+
+	c, _ := Parse("850709-4830")
+	county, _ := c.BirthCounty()
+	assert.Equal(t, "Göteborgs och Bohus län", county.String())
+}
+
+func TestBirthCountyUnknown(t *testing.T) {
+	for _, in := range []string{"20040229-1231", "850769-9802", "850709-T224", "850709-6538"} {
+		p, err := Parse(in, &Options{AllowInterimNumber: true})
+		assert.Nil(t, err, in)
+
+		_, ok := p.BirthCounty()
+		assert.False(t, ok, in)
+	}
+}
@@ -0,0 +1,79 @@
+package personnummer
+
+import (
+	"fmt"
+	"time"
+)
+
+// EnumerateOptions represents the options for enumerating numbers.
+type EnumerateOptions struct {
+	// Coordination also enumerates coordination numbers. It is ignored
+	// when Options.DisableCoordinationNumber is set.
+	Coordination bool
+
+	// Interim also enumerates interim numbers.
+	Interim bool
+
+	// Options are the options the numbers are created with.
+	// The reference date chooses the separator.
+	Options *Options
+}
+
+// Enumerate calls yield for every valid Swedish personal identity number with
+// the given date of birth, in order of serial number, until yield returns false.
+// The signature of yield matches the one of iter.Seq.
+// Returns false if yield stopped the enumeration.
+func Enumerate(date time.Time, opts *EnumerateOptions, yield func(*Personnummer) bool) bool {
+	if opts == nil {
+		opts = &EnumerateOptions{}
+	}
+
+	options := *optionsOf(opts.Options)
+	if opts.Interim {
+		options.AllowInterimNumber = true
+	}
+
+	birth := calendarDate(date)
+
+	coordination := []bool{false}
+	if opts.Coordination && !options.DisableCoordinationNumber {
+		coordination = append(coordination, true)
+	}
+
+	for _, c := range coordination {
+		for serial := 1; serial < 1000; serial++ {
+			if !yield(fromParts(birth, fmt.Sprintf("%03d", serial), c, &options)) {
+				return false
+			}
+		}
+
+		if !opts.Interim {
+			continue
+		}
+
+		for _, letter := range interimLetters {
+			for serial := 0; serial < 100; serial++ {
+				if !yield(fromParts(birth, fmt.Sprintf("%c%02d", letter, serial), c, &options)) {
+					return false
+				}
+			}
+		}
+	}
+
+	return true
+}
+
+// EnumerateRange calls yield for every valid Swedish personal identity number
+// with a date of birth between from and to, inclusive, until yield returns false.
+// Returns false if yield stopped the enumeration.
+func EnumerateRange(from, to time.Time, opts *EnumerateOptions, yield func(*Personnummer) bool) bool {
+	end := calendarDate(to)
+
+	for date := calendarDate(from); !date.After(end); date = date.AddDate(0, 0, 1) {
+		if !Enumerate(date, opts, yield) {
+			return false
+		}
+	}
+
+	return true
+}
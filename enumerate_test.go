@@ -0,0 +1,95 @@
+package personnummer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/frozzare/go-assert"
+)
+
+func TestEnumerate(t *testing.T) {
+	date := time.Date(1985, 7, 9, 0, 0, 0, 0, time.UTC)
+	seen := map[string]bool{}
+
+	Enumerate(date, nil, func(p *Personnummer) bool {
+		v, _ := p.Format()
+		assert.True(t, Valid(v), v)
+		assert.Equal(t, date, p.GetDate())
+		seen[v] = true
+		return true
+	})
+
+	assert.Equal(t, 999, len(seen))
+	assert.True(t, seen["850709-9805"])
+	assert.True(t, seen["850709-1232"])
+}
+
+func TestEnumerateKinds(t *testing.T) {
+	date := time.Date(1985, 7, 9, 0, 0, 0, 0, time.UTC)
+	opts := &EnumerateOptions{Coordination: true, Interim: true}
+	counts := map[Kind]int{}
+
+	Enumerate(date, opts, func(p *Personnummer) bool {
+		v, _ := p.Format()
+		assert.True(t, Valid(v, &Options{AllowInterimNumber: true}), v)
+		counts[p.Kind()]++
+		return true
+	})
+
+	assert.Equal(t, 999, counts[KindOrdinary])
+	assert.Equal(t, 999, counts[KindCoordination])
+	assert.Equal(t, 1100, counts[KindInterim])
+	assert.Equal(t, 1100, counts[KindInterimCoordination])
+}
+
+func TestEnumerateRange(t *testing.T) {
+	from := time.Date(2000, 2, 28, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2000, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	n := 0
+	assert.True(t, EnumerateRange(from, to, nil, func(p *Personnummer) bool {
+		n++
+		return true
+	}))
+	assert.Equal(t, 3*999, n)
+
+	n = 0
+	assert.False(t, EnumerateRange(from, to, nil, func(p *Personnummer) bool {
+		n++
+		return n < 10
+	}))
+	assert.Equal(t, 10, n)
+}
+
+func TestEnumerateOptions(t *testing.T) {
+	date := time.Date(1885, 7, 9, 0, 0, 0, 0, time.UTC)
+	opts := &EnumerateOptions{Options: &Options{
+		DisableSexInference: true,
+		TextFormat:          TextFormatShort,
+		ReferenceDate:       time.Date(1980, 1, 1, 0, 0, 0, 0, time.UTC),
+	}}
+
+	Enumerate(date, opts, func(p *Personnummer) bool {
+		assert.Equal(t, "-", p.Sep)
+		assert.Equal(t, SexUnknown, p.Sex())
+
+		text, _ := p.MarshalText()
+		assert.Equal(t, "850709-0010", string(text))
+		return false
+	})
+}
+
+func TestEnumerateDisableCoordination(t *testing.T) {
+	date := time.Date(1985, 7, 9, 0, 0, 0, 0, time.UTC)
+	options := &Options{DisableCoordinationNumber: true}
+
+	count := 0
+	Enumerate(date, &EnumerateOptions{Coordination: true, Options: options}, func(p *Personnummer) bool {
+		v, _ := p.Format()
+		assert.True(t, Valid(v, options), v)
+		count++
+		return true
+	})
+
+	assert.Equal(t, 999, count)
+}
@@ -0,0 +1,105 @@
+package personnummer
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrInvalidLength is returned when the number does not contain 10 or 12 digits.
+	ErrInvalidLength = errors.New("invalid length")
+
+	// ErrInvalidCharacter is returned when the number contains a character
+	// that is not a digit, a separator or an interim letter.
+	ErrInvalidCharacter = errors.New("invalid character")
+
+	// ErrZeroSerial is returned when the serial number is 000.
+	ErrZeroSerial = errors.New("serial number cannot be zero")
+
+	// ErrInvalidSerial is returned when a serial number is out of range.
+	ErrInvalidSerial = errors.New("invalid serial number")
+
+	// ErrInvalidMonth is returned when the month does not exist.
+	ErrInvalidMonth = errors.New("invalid month")
+
+	// ErrInvalidDay is returned when the day does not exist in the given month.
+	ErrInvalidDay = errors.New("invalid day")
+
+	// ErrInvalidChecksum is returned when the check digit does not match.
+	ErrInvalidChecksum = errors.New("invalid check digit")
+
+	// ErrInvalidCentury is returned when the century and year do not match the full year.
+	ErrInvalidCentury = errors.New("century and year do not match the full year")
+
+	// ErrInvalidSeparator is returned when the separator does not match the age.
+	ErrInvalidSeparator = errors.New("invalid separator")
+
+	// ErrCoordinationNumberNotAllowed is returned for coordination numbers
+	// when Options.DisableCoordinationNumber is set.
+	ErrCoordinationNumberNotAllowed = errors.New("coordination numbers are not allowed")
+
+	// ErrInterimNumberNotAllowed is returned for interim numbers
+	// unless Options.AllowInterimNumber is set.
+	ErrInterimNumberNotAllowed = errors.New("interim numbers are not allowed")
+
+	// ErrInvalidGroupNumber is returned for organisation numbers
+	// starting with 0, which is not a group number.
+	ErrInvalidGroupNumber = errors.New("invalid group number")
+
+	// ErrInvalidKind is returned when building a number of an unknown Kind.
+	ErrInvalidKind = errors.New("invalid kind")
+
+	// ErrInvalidOrganisationsnummer is returned for organisation numbers with
+	// the 16 prefix and a third digit less than 2, which is a personnummer.
+	ErrInvalidOrganisationsnummer = errors.New("third digit of an organisation number must be at least 2")
+
+	// ErrInvalidVATNumber is returned for VAT numbers not laid out as SE, 10 digits and 01.
+	ErrInvalidVATNumber = errors.New("invalid VAT number")
+
+	// ErrInvalidEncoding is returned by FromUint64 and UnmarshalBinary
+	// for values that are not an encoded number.
+	ErrInvalidEncoding = errors.New("invalid encoding")
+)
+
+// ParseError describes why a Swedish personal identity number, organisation
+// number or VAT number could not be parsed.
+// Use errors.Is with one of the Err variables to check the reason.
+type ParseError struct {
+	// Input is the string that was parsed. It is left out of Error
+	// to keep identity numbers out of logs.
+	Input string
+
+	// Offset is the byte offset in Input of the offending character,
+	// or -1 when the error is not tied to a single character.
+	Offset int
+
+	// Err is the reason, one of the Err variables.
+	Err error
+
+	// subject is what was parsed, a personal identity number if empty.
+	subject string
+}
+
+const (
+	subjectOrganisationsnummer = "organisation number"
+	subjectVATNumber           = "VAT number"
+)
+
+// Error implements the error interface.
+func (e *ParseError) Error() string {
+	subject := e.subject
+	if subject == "" {
+		subject = "personal identity number"
+	}
+
+	if e.Offset < 0 {
+		return fmt.Sprintf("Invalid swedish %s: %v", subject, e.Err)
+	}
+
+	return fmt.Sprintf("Invalid swedish %s: %v at offset %d", subject, e.Err, e.Offset)
+}
+
+// Unwrap returns the underlying reason.
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
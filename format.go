@@ -0,0 +1,120 @@
+package personnummer
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// layoutSeparatedLong is the long format with separator used by %+v.
+const layoutSeparatedLong = "YYYYMMDDSNNNC"
+
+// FormatLayout formats a Swedish personal identity number after a layout
+// where the following tokens are replaced and anything else is kept as is:
+//
+//	CC    century
+//	YYYY  full year
+//	YY    year
+//	MM    month
+//	DD    day
+//	S     separator, - or +
+//	NNN   serial number, N and NN for its last digits
+//	C     check digit
+//	*     masked digit
+//
+// For example "YYYYMMDD-NNNC" gives 19850709-9805 and "YYMMDD-****" 850709-****.
+func (p *Personnummer) FormatLayout(layout string) (string, error) {
+	var b strings.Builder
+
+	for i := 0; i < len(layout); {
+		c := layout[i]
+
+		n := 1
+		for i+n < len(layout) && layout[i+n] == c {
+			n++
+		}
+
+		switch {
+		case c == 'C' && n == 2:
+			b.WriteString(p.Century)
+		case c == 'C' && n == 1:
+			b.WriteString(p.Check)
+		case c == 'Y' && n == 4:
+			b.WriteString(p.FullYear)
+		case c == 'Y' && n == 2:
+			b.WriteString(p.Year)
+		case c == 'M' && n == 2:
+			b.WriteString(p.Month)
+		case c == 'D' && n == 2:
+			b.WriteString(p.Day)
+		case c == 'N' && n <= len(p.Num):
+			b.WriteString(p.Num[len(p.Num)-n:])
+		case c == 'S' && n == 1:
+			b.WriteString(p.Sep)
+		case c == 'C' || c == 'Y' || c == 'M' || c == 'D' || c == 'N' || c == 'S':
+			return "", fmt.Errorf("personnummer: invalid layout %q, unknown token %q", layout, layout[i:i+n])
+		default:
+			b.WriteString(layout[i : i+n])
+		}
+
+		i += n
+	}
+
+	return b.String(), nil
+}
+
+// String returns the short format of the number, YYMMDD-NNNC.
+func (p *Personnummer) String() string {
+	v, _ := p.Format()
+	return v
+}
+
+// formatter formats a number with the fmt verbs.
+type formatter struct {
+	p *Personnummer
+}
+
+// Formatter returns a fmt.Formatter for the number. The verbs %s and %v give
+// the short format YYMMDD-NNNC, %+v the long format with separator
+// YYYYMMDD-NNNC, %L the long format YYYYMMDDNNNC and %q a quoted short format.
+// Personnummer itself only implements fmt.Stringer, as its Format method
+// predates fmt.Formatter.
+func (p *Personnummer) Formatter() fmt.Formatter {
+	return formatter{p}
+}
+
+// Format implements the fmt.Formatter interface.
+func (x formatter) Format(f fmt.State, verb rune) {
+	p := x.p
+
+	var v string
+
+	switch {
+	case verb == 'v' && f.Flag('#'):
+		type plain Personnummer
+		fmt.Fprintf(f, "%#v", (*plain)(p))
+		return
+	case verb == 'v' && f.Flag('+'):
+		v, _ = p.FormatLayout(layoutSeparatedLong)
+	case verb == 'v' || verb == 's':
+		v = p.String()
+	case verb == 'L':
+		v, _ = p.Format(true)
+	case verb == 'q':
+		v = strconv.Quote(p.String())
+	default:
+		fmt.Fprintf(f, "%%!%c(personnummer=%s)", verb, p.String())
+		return
+	}
+
+	if w, ok := f.Width(); ok && len(v) < w {
+		pad := strings.Repeat(" ", w-len(v))
+		if f.Flag('-') {
+			v += pad
+		} else {
+			v = pad + v
+		}
+	}
+
+	fmt.Fprint(f, v)
+}
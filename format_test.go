@@ -0,0 +1,50 @@
+package personnummer
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/frozzare/go-assert"
+)
+
+func TestFormatLayout(t *testing.T) {
+	p, _ := Parse("198507099805")
+
+	tests := map[string]string{
+		"YYYYMMDDNNNC":    "198507099805",
+		"YYMMDDSNNNC":     "850709-9805",
+		"YYYYMMDD-NNNC":   "19850709-9805",
+		"YYMMDD NNNC":     "850709 9805",
+		"YYMMDD-****":     "850709-****",
+		"********-NNNC":   "********-9805",
+		"CC":              "19",
+		"DD/MM/YYYY":      "09/07/1985",
+		"född YYYY-MM-DD": "född 1985-07-09",
+	}
+
+	for layout, expected := range tests {
+		v, err := p.FormatLayout(layout)
+		assert.Nil(t, err, layout)
+		assert.Equal(t, expected, v, layout)
+	}
+
+	for _, layout := range []string{"YYY", "MMM", "D", "NNNN", "CCC", "SS"} {
+		_, err := p.FormatLayout(layout)
+		assert.NotNil(t, err, layout)
+	}
+}
+
+func TestFormatter(t *testing.T) {
+	p, _ := Parse("198507099805")
+
+	assert.Equal(t, "850709-9805", p.String())
+	assert.Equal(t, "850709-9805", fmt.Sprintf("%v", p))
+	assert.Equal(t, "850709-9805", fmt.Sprintf("%s", p.Formatter()))
+	assert.Equal(t, "850709-9805", fmt.Sprintf("%v", p.Formatter()))
+	assert.Equal(t, "19850709-9805", fmt.Sprintf("%+v", p.Formatter()))
+	assert.Equal(t, "198507099805", fmt.Sprintf("%L", p.Formatter()))
+	assert.Equal(t, `"850709-9805"`, fmt.Sprintf("%q", p.Formatter()))
+	assert.Equal(t, "  850709-9805", fmt.Sprintf("%13s", p.Formatter()))
+	assert.Equal(t, "850709-9805  |", fmt.Sprintf("%-13s|", p.Formatter()))
+	assert.Equal(t, "%!d(personnummer=850709-9805)", fmt.Sprintf("%d", p.Formatter()))
+}
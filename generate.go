@@ -0,0 +1,145 @@
+package personnummer
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+)
+
+// InvalidRule represents a rule a generated number should fail.
+type InvalidRule int
+
+const (
+	// InvalidNone generates valid numbers.
+	InvalidNone InvalidRule = iota
+
+	// InvalidChecksum generates numbers with a wrong check digit.
+	InvalidChecksum
+
+	// InvalidDate generates numbers with a day that does not exist.
+	InvalidDate
+
+	// InvalidZeroSerial generates numbers with the serial number 000,
+	// ignoring Sex and Interim.
+	InvalidZeroSerial
+)
+
+// GenerateOptions represents the options for generated numbers.
+type GenerateOptions struct {
+	// From and To is the range of dates of birth, inclusive. The zero values
+	// mean 99 years before To and the reference date. They are swapped if
+	// From is after To.
+	From time.Time
+	To   time.Time
+
+	// Sex of the generated number, any sex if SexUnknown.
+	Sex Sex
+
+	// Coordination generates coordination numbers. It is ignored when
+	// Options.DisableCoordinationNumber is set.
+	Coordination bool
+
+	// Interim generates interim numbers.
+	Interim bool
+
+	// LongFormat makes GenerateString return the long format.
+	LongFormat bool
+
+	// Invalid is the rule the generated number should fail.
+	Invalid InvalidRule
+
+	// Rand is the source of randomness, used to make generated numbers
+	// reproducible. The default source is used if nil.
+	Rand *rand.Rand
+
+	// Options are the options the numbers are created with. The reference
+	// date chooses the separator and the default range of dates of birth.
+	Options *Options
+}
+
+// intn returns a random number in [0, n).
+func (o *GenerateOptions) intn(n int) int {
+	if o.Rand == nil {
+		return rand.Intn(n)
+	}
+
+	return o.Rand.Intn(n)
+}
+
+// Generate returns a random Swedish personal identity number.
+// Numbers generated with an Invalid rule fail only that rule.
+func Generate(opts *GenerateOptions) *Personnummer {
+	if opts == nil {
+		opts = &GenerateOptions{}
+	}
+
+	options := *optionsOf(opts.Options)
+	if opts.Interim {
+		options.AllowInterimNumber = true
+	}
+
+	to := calendarDate(opts.To)
+	if opts.To.IsZero() {
+		to = calendarDate(options.referenceDate())
+	}
+
+	from := calendarDate(opts.From)
+	if opts.From.IsZero() {
+		from = to.AddDate(-99, 0, 0)
+	}
+
+	if from.After(to) {
+		from, to = to, from
+	}
+
+	days := int(to.Sub(from).Hours()/24) + 1
+	coordination := opts.Coordination && !options.DisableCoordinationNumber
+
+	birth := from.AddDate(0, 0, opts.intn(days))
+
+	sex := opts.intn(5) * 2
+	switch opts.Sex {
+	case SexMale:
+		sex++
+	case SexUnknown:
+		sex += opts.intn(2)
+	}
+
+	var num string
+	if opts.Interim {
+		num = fmt.Sprintf("%c%d%d", interimLetters[opts.intn(len(interimLetters))], opts.intn(10), sex)
+	} else {
+		num = fmt.Sprintf("%02d%d", opts.intn(100), sex)
+		for num == "000" {
+			num = fmt.Sprintf("%02d%d", opts.intn(100), sex)
+		}
+	}
+
+	if opts.Invalid == InvalidZeroSerial {
+		num = "000"
+	}
+
+	p := fromParts(birth, num, coordination, &options)
+
+	switch opts.Invalid {
+	case InvalidChecksum:
+		p.Check = string((p.Check[0]-'0'+byte(opts.intn(9))+1)%10 + '0')
+	case InvalidDate:
+		day := daysIn(birth.Year(), birth.Month()) + 1
+		if coordination {
+			day += 60
+		}
+
+		p.Day = fmt.Sprintf("%02d", day)
+		p.Check = string(p.checkDigit())
+	}
+
+	return p
+}
+
+// GenerateString returns a random Swedish personal identity number
+// in the short format, or the long format if opts.LongFormat is set.
+func GenerateString(opts *GenerateOptions) string {
+	v, _ := Generate(opts).Format(opts != nil && opts.LongFormat)
+	return v
+}
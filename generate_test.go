@@ -0,0 +1,110 @@
+package personnummer
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/frozzare/go-assert"
+)
+
+func TestGenerate(t *testing.T) {
+	from := time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(1990, 12, 31, 0, 0, 0, 0, time.UTC)
+	r := rand.New(rand.NewSource(1))
+
+	for i := 0; i < 1000; i++ {
+		p := Generate(&GenerateOptions{From: from, To: to, Sex: SexFemale, Rand: r})
+
+		v, _ := p.Format()
+		assert.True(t, Valid(v), v)
+		assert.True(t, p.IsFemale(), v)
+		assert.False(t, p.IsCoordinationNumber(), v)
+		assert.False(t, p.GetDate().Before(from), v)
+		assert.False(t, p.GetDate().After(to), v)
+	}
+}
+
+func TestGenerateKinds(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	for i := 0; i < 100; i++ {
+		p := Generate(&GenerateOptions{Coordination: true, Sex: SexMale, Rand: r})
+		v, _ := p.Format(true)
+		assert.True(t, Valid(v), v)
+		assert.True(t, p.IsCoordinationNumber(), v)
+		assert.True(t, p.IsMale(), v)
+
+		p = Generate(&GenerateOptions{Interim: true, Rand: r})
+		v, _ = p.Format()
+		assert.True(t, Valid(v, &Options{AllowInterimNumber: true}), v)
+		assert.True(t, p.IsInterimNumber(), v)
+	}
+
+	assert.Equal(t, 12, len(GenerateString(&GenerateOptions{LongFormat: true})))
+	assert.Equal(t, 11, len(GenerateString(&GenerateOptions{})))
+}
+
+func TestGenerateOptions(t *testing.T) {
+	ref := time.Date(1980, 1, 1, 0, 0, 0, 0, time.UTC)
+	p := Generate(&GenerateOptions{Options: &Options{DisableSexInference: true, TextFormat: TextFormatShort, ReferenceDate: ref}})
+
+	assert.False(t, p.GetDate().After(ref))
+	assert.Equal(t, SexUnknown, p.Sex())
+
+	text, _ := p.MarshalText()
+	assert.Equal(t, 11, len(text))
+
+	assert.Equal(t, 11, len(GenerateString(nil)))
+}
+
+func TestGenerateRange(t *testing.T) {
+	from := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2000, 1, 31, 0, 0, 0, 0, time.UTC)
+
+	for i := 0; i < 100; i++ {
+		date := Generate(&GenerateOptions{From: to, To: from}).GetDate()
+		assert.False(t, date.Before(from), date)
+		assert.False(t, date.After(to), date)
+	}
+}
+
+func TestGenerateDisableCoordination(t *testing.T) {
+	options := &Options{DisableCoordinationNumber: true}
+
+	for i := 0; i < 100; i++ {
+		p := Generate(&GenerateOptions{Coordination: true, Options: options})
+		assert.Equal(t, KindOrdinary, p.Kind())
+
+		v, _ := p.Format()
+		assert.True(t, Valid(v, options), v)
+	}
+}
+
+func TestGenerateReproducible(t *testing.T) {
+	a := GenerateString(&GenerateOptions{Rand: rand.New(rand.NewSource(42))})
+	b := GenerateString(&GenerateOptions{Rand: rand.New(rand.NewSource(42))})
+	assert.Equal(t, a, b)
+}
+
+func TestGenerateInvalid(t *testing.T) {
+	tests := map[InvalidRule]error{
+		InvalidChecksum:   ErrInvalidChecksum,
+		InvalidDate:       ErrInvalidDay,
+		InvalidZeroSerial: ErrZeroSerial,
+	}
+
+	r := rand.New(rand.NewSource(1))
+
+	for rule, expected := range tests {
+		for i := 0; i < 100; i++ {
+			v := GenerateString(&GenerateOptions{Invalid: rule, Coordination: i%2 == 0, Rand: r})
+			_, err := Parse(v)
+			assert.True(t, errors.Is(err, expected), v)
+		}
+	}
+
+	v := GenerateString(&GenerateOptions{Invalid: InvalidZeroSerial, Sex: SexMale, Rand: r})
+	assert.Equal(t, "000", v[7:10])
+}
@@ -0,0 +1,196 @@
+package personnummer
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Normalization is a set of changes made by Normalize.
+type Normalization uint8
+
+const (
+	// NormalizedPrefix is set when a prefix such as "pnr:" was removed.
+	NormalizedPrefix Normalization = 1 << iota
+
+	// NormalizedSpace is set when spaces, including non-breaking
+	// and zero width spaces, were removed.
+	NormalizedSpace
+
+	// NormalizedSeparator is set when a Unicode dash or plus sign
+	// was replaced with '-' or '+'.
+	NormalizedSeparator
+
+	// NormalizedDigit is set when full-width digits were replaced.
+	NormalizedDigit
+)
+
+// normalizationNames are the names of the normalizations.
+var normalizationNames = []struct {
+	n    Normalization
+	name string
+}{
+	{NormalizedPrefix, "prefix"},
+	{NormalizedSpace, "space"},
+	{NormalizedSeparator, "separator"},
+	{NormalizedDigit, "digit"},
+}
+
+// Has checks if all normalizations in f are set.
+func (n Normalization) Has(f Normalization) bool {
+	return n&f == f
+}
+
+// String returns the names of the normalizations separated by '|'.
+func (n Normalization) String() string {
+	if n == 0 {
+		return "none"
+	}
+
+	var names []string
+	for _, v := range normalizationNames {
+		if n.Has(v.n) {
+			names = append(names, v.name)
+		}
+	}
+
+	return strings.Join(names, "|")
+}
+
+// Normalization returns the normalizations made to the input when
+// parsed with Options.Lenient.
+func (p *Personnummer) Normalization() Normalization {
+	return p.normalization
+}
+
+// Normalize returns the input with a known prefix such as "pnr:" or
+// "personnummer" removed, spaces removed, Unicode dashes and plus signs
+// replaced with '-' and '+', and full-width digits replaced with ASCII
+// digits, along with the normalizations made. Input that only holds
+// digits, separators and interim letters is returned as is.
+func Normalize(in string) (string, Normalization) {
+	clean := true
+	for i := 0; i < len(in) && clean; i++ {
+		clean = isDigit(in[i]) || in[i] == '-' || in[i] == '+' || isInterimLetter(in[i])
+	}
+
+	if clean {
+		return in, 0
+	}
+
+	var b strings.Builder
+	b.Grow(len(in))
+
+	n := normalize(in, func(_ int, r rune) {
+		b.WriteRune(r)
+	})
+
+	return b.String(), n
+}
+
+// normalize walks the input, calling emit with the byte offset in the input
+// and the rune of each character kept, and returns the normalizations made.
+func normalize(in string, emit func(offset int, r rune)) Normalization {
+	var n Normalization
+
+	i := 0
+	for i < len(in) {
+		r, size := utf8.DecodeRuneInString(in[i:])
+		if !isSpace(r) {
+			break
+		}
+		i += size
+		n |= NormalizedSpace
+	}
+
+	if end := prefixEnd(in[i:]); end > 0 {
+		i += end
+		n |= NormalizedPrefix
+	}
+
+	for i < len(in) {
+		r, size := utf8.DecodeRuneInString(in[i:])
+
+		switch {
+		case isSpace(r):
+			n |= NormalizedSpace
+		case r >= '\uff10' && r <= '\uff19':
+			emit(i, r-'\uff10'+'0')
+			n |= NormalizedDigit
+		case r == '\uff0b' || r == '\ufe62':
+			emit(i, '+')
+			n |= NormalizedSeparator
+		case r != '-' && (unicode.Is(unicode.Pd, r) || r == '\u2212'):
+			emit(i, '-')
+			n |= NormalizedSeparator
+		default:
+			emit(i, r)
+		}
+
+		i += size
+	}
+
+	return n
+}
+
+// isSpace checks if r is a space, including zero width spaces.
+func isSpace(r rune) bool {
+	return unicode.IsSpace(r) || r == '\u200b' || r == '\ufeff'
+}
+
+// prefixEnd returns the length of a keyword prefix of in, with the colon
+// and spaces after it, or 0 if in does not start with one.
+func prefixEnd(in string) int {
+	for _, k := range keywords {
+		if len(in) <= len(k) || !strings.EqualFold(in[:len(k)], k) {
+			continue
+		}
+
+		i := len(k)
+		if in[i] == ':' {
+			i++
+		} else if r, _ := utf8.DecodeRuneInString(in[i:]); !isSpace(r) && !isDigit(in[i]) {
+			continue
+		}
+
+		for i < len(in) {
+			r, size := utf8.DecodeRuneInString(in[i:])
+			if !isSpace(r) {
+				break
+			}
+			i += size
+		}
+
+		return i
+	}
+
+	return 0
+}
+
+// originalOffset returns the byte offset in the input of the character at
+// the given byte offset of the normalized input.
+func originalOffset(in string, offset int) int {
+	if offset < 0 {
+		return offset
+	}
+
+	result, out := -1, 0
+	normalize(in, func(i int, r rune) {
+		if out == offset {
+			result = i
+		}
+		out += utf8.RuneLen(r)
+	})
+
+	return result
+}
+
+// parseError returns a *ParseError with the offset in the input, for
+// offsets in the input after the given normalizations.
+func parseError(input string, norm Normalization, offset int, err error) error {
+	if norm != 0 {
+		offset = originalOffset(input, offset)
+	}
+
+	return &ParseError{Input: input, Offset: offset, Err: err}
+}
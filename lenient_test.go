@@ -0,0 +1,82 @@
+package personnummer
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/frozzare/go-assert"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+		norm     Normalization
+	}{
+		{"850709-9805", "850709-9805", 0},
+		{"850709 9805", "8507099805", NormalizedSpace},
+		{"850709 - 9805", "850709-9805", NormalizedSpace},
+		{"850709–9805", "850709-9805", NormalizedSeparator},
+		{"850709−9805", "850709-9805", NormalizedSeparator},
+		{"850709＋9805", "850709+9805", NormalizedSeparator},
+		{"８５０７０９-９８０５", "850709-9805", NormalizedDigit},
+		{"pnr:850709-9805", "850709-9805", NormalizedPrefix},
+		{" Personnummer: 850709-9805 ", "850709-9805", NormalizedPrefix | NormalizedSpace},
+		{"PNR 850709‑9805", "850709-9805", NormalizedPrefix | NormalizedSeparator},
+		{"pnrx850709-9805", "pnrx850709-9805", 0},
+	}
+
+	for _, test := range tests {
+		out, norm := Normalize(test.input)
+		assert.Equal(t, test.expected, out, test.input)
+		assert.Equal(t, test.norm, norm, test.input)
+	}
+}
+
+func TestNormalizationString(t *testing.T) {
+	assert.Equal(t, "none", Normalization(0).String())
+	assert.Equal(t, "prefix|separator", (NormalizedPrefix | NormalizedSeparator).String())
+	assert.True(t, (NormalizedPrefix | NormalizedDigit).Has(NormalizedDigit))
+	assert.False(t, NormalizedPrefix.Has(NormalizedPrefix|NormalizedDigit))
+}
+
+func TestLenient(t *testing.T) {
+	lenient := &Options{Lenient: true}
+
+	for _, in := range []string{"850709 9805", "850709–9805", "pnr: 850709-9805", "８５０７０９９８０５"} {
+		assert.False(t, Valid(in), in)
+		assert.True(t, Valid(in, lenient), in)
+		assert.True(t, ValidBytes([]byte(in), lenient), in)
+
+		p, err := Parse(in, lenient)
+		assert.Nil(t, err, in)
+		assert.Equal(t, "19850709", p.FullYear+p.Month+p.Day)
+		assert.Equal(t, "9805", p.Num+p.Check)
+		assert.NotEqual(t, Normalization(0), p.Normalization())
+	}
+
+	p, err := Parse("850709-9805", lenient)
+	assert.Nil(t, err)
+	assert.Equal(t, Normalization(0), p.Normalization())
+
+	p, err = Parse("personnummer: 850709–T224", &Options{Lenient: true, AllowInterimNumber: true})
+	assert.Nil(t, err)
+	assert.Equal(t, NormalizedPrefix|NormalizedSeparator, p.Normalization())
+	assert.Equal(t, KindInterim, p.Kind())
+}
+
+func TestLenientErrors(t *testing.T) {
+	lenient := &Options{Lenient: true}
+
+	_, err := Parse("pnr: 850709–980x", lenient)
+	var pe *ParseError
+	assert.True(t, errors.As(err, &pe))
+	assert.Equal(t, ErrInvalidCharacter, pe.Err)
+	assert.Equal(t, "pnr: 850709–980x", pe.Input)
+	assert.Equal(t, 17, pe.Offset)
+
+	_, err = Parse("pnr: 851309–9805", lenient)
+	assert.True(t, errors.As(err, &pe))
+	assert.Equal(t, ErrInvalidMonth, pe.Err)
+	assert.Equal(t, 7, pe.Offset)
+}
@@ -0,0 +1,128 @@
+package personnummer
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// TextFormat represents the format used when marshalling a number.
+type TextFormat int
+
+const (
+	// TextFormatLong is the long format, YYYYMMDDNNNC.
+	TextFormatLong TextFormat = iota
+
+	// TextFormatShort is the short format with separator, YYMMDD-NNNC.
+	TextFormatShort
+
+	// TextFormatMasked is the long format with separator and the last four
+	// digits masked, YYYYMMDD-****. Masked numbers cannot be unmarshalled.
+	TextFormatMasked
+)
+
+// SetTextFormat sets the format used when marshalling the number.
+func (p *Personnummer) SetTextFormat(f TextFormat) {
+	p.options.TextFormat = f
+}
+
+// isZero checks if none of the fields of the number are set.
+func (p *Personnummer) isZero() bool {
+	return p.Century+p.FullYear+p.Year+p.Month+p.Day+p.Sep+p.Num+p.Check == ""
+}
+
+// longFormat returns the long format, or a *ParseError
+// if not all fields are set.
+func (p *Personnummer) longFormat() (string, error) {
+	long := p.Century + p.Year + p.Month + p.Day + p.Num + p.Check
+	if len(long) != lengthWithCentury || p.FullYear == "" {
+		return "", &ParseError{Input: long, Offset: -1, Err: ErrInvalidLength}
+	}
+
+	return long, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// Numbers without all fields set return an error.
+func (p *Personnummer) MarshalText() ([]byte, error) {
+	if _, err := p.longFormat(); err != nil {
+		return nil, err
+	}
+
+	var v string
+
+	switch p.options.TextFormat {
+	case TextFormatShort:
+		v, _ = p.Format()
+	case TextFormatMasked:
+		v = p.FullYear + p.Month + p.Day + "-****"
+	default:
+		v, _ = p.Format(true)
+	}
+
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// The text is parsed like Parse with the options the number was created
+// with, none for the zero value, and invalid numbers return a *ParseError.
+func (p *Personnummer) UnmarshalText(text []byte) error {
+	options := p.options
+
+	v, err := Parse(string(text), &options)
+	if err != nil {
+		return err
+	}
+
+	*p = *v
+
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// The number is marshalled as a JSON string, see MarshalText,
+// and the zero value as null.
+func (p *Personnummer) MarshalJSON() ([]byte, error) {
+	if p.isZero() {
+		return []byte("null"), nil
+	}
+
+	text, err := p.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// Only JSON strings are accepted, null is a no-op.
+func (p *Personnummer) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("personnummer: cannot unmarshal a JSON %s into a personal identity number", jsonKind(data))
+	}
+
+	return p.UnmarshalText([]byte(s))
+}
+
+// jsonKind returns the kind of a JSON value, without the value itself.
+func jsonKind(data []byte) string {
+	if len(data) == 0 {
+		return "value"
+	}
+
+	switch data[0] {
+	case '{':
+		return "object"
+	case '[':
+		return "array"
+	case 't', 'f':
+		return "boolean"
+	default:
+		return "number"
+	}
+}
@@ -0,0 +1,105 @@
+package personnummer
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/frozzare/go-assert"
+)
+
+type marshalTest struct {
+	Name string        `json:"name"`
+	PIN  *Personnummer `json:"pin"`
+}
+
+func TestMarshalJSON(t *testing.T) {
+	p, _ := Parse("850709-9805")
+
+	b, err := json.Marshal(marshalTest{Name: "Test", PIN: p})
+	assert.Nil(t, err)
+	assert.Equal(t, `{"name":"Test","pin":"198507099805"}`, string(b))
+
+	p, _ = Parse("850709-9805", &Options{TextFormat: TextFormatShort})
+	b, _ = json.Marshal(p)
+	assert.Equal(t, `"850709-9805"`, string(b))
+
+	p.SetTextFormat(TextFormatMasked)
+	b, _ = json.Marshal(p)
+	assert.Equal(t, `"19850709-****"`, string(b))
+
+	b, _ = json.Marshal(marshalTest{})
+	assert.Equal(t, `{"name":"","pin":null}`, string(b))
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	var v marshalTest
+	assert.Nil(t, json.Unmarshal([]byte(`{"name":"Test","pin":"850709-9805"}`), &v))
+	assert.Equal(t, "1985", v.PIN.FullYear)
+	assert.Equal(t, "9805", v.PIN.Num+v.PIN.Check)
+
+	b, _ := json.Marshal(v)
+	assert.Equal(t, `{"name":"Test","pin":"198507099805"}`, string(b))
+
+	v = marshalTest{}
+	assert.Nil(t, json.Unmarshal([]byte(`{"pin":null}`), &v))
+	assert.Nil(t, v.PIN)
+
+	err := json.Unmarshal([]byte(`{"pin":"850709-9806"}`), &v)
+	assert.True(t, errors.Is(err, ErrInvalidChecksum))
+
+	err = json.Unmarshal([]byte(`{"pin":"850709-T224"}`), &v)
+	assert.True(t, errors.Is(err, ErrInterimNumberNotAllowed))
+
+	err = json.Unmarshal([]byte(`{"pin":8507099805}`), &v)
+	assert.NotNil(t, err)
+	assert.False(t, strings.Contains(err.Error(), "8507099805"))
+
+	err = json.Unmarshal([]byte(`{"pin":"19850709-****"}`), &v)
+	assert.True(t, errors.Is(err, ErrInvalidCharacter))
+}
+
+func TestText(t *testing.T) {
+	p := &Personnummer{}
+	p.SetTextFormat(TextFormatShort)
+	assert.Nil(t, p.UnmarshalText([]byte("198507099805")))
+
+	text, err := p.MarshalText()
+	assert.Nil(t, err)
+	assert.Equal(t, "850709-9805", string(text))
+}
+
+type marshalValueTest struct {
+	PIN Personnummer `json:"pin"`
+}
+
+func TestMarshalZeroValue(t *testing.T) {
+	var v marshalValueTest
+
+	b, err := json.Marshal(&v)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"pin":null}`, string(b))
+
+	var w marshalValueTest
+	assert.Nil(t, json.Unmarshal(b, &w))
+	assert.Equal(t, v, w)
+
+	_, err = (&Personnummer{}).MarshalText()
+	assert.True(t, errors.Is(err, ErrInvalidLength))
+
+	_, err = (&Personnummer{Century: "19"}).MarshalText()
+	assert.True(t, errors.Is(err, ErrInvalidLength))
+}
+
+func TestUnmarshalTextOptions(t *testing.T) {
+	p, _ := Parse("850709-9805", &Options{AllowInterimNumber: true, TextFormat: TextFormatShort})
+	assert.Nil(t, p.UnmarshalText([]byte("850709-T224")))
+	assert.Equal(t, KindInterim, p.Kind())
+
+	text, _ := p.MarshalText()
+	assert.Equal(t, "850709-T224", string(text))
+
+	var q Personnummer
+	assert.True(t, errors.Is(q.UnmarshalText([]byte("850709-T224")), ErrInterimNumberNotAllowed))
+}
@@ -0,0 +1,71 @@
+package personnummer
+
+// defaultMaskPattern is the pattern used by Masked when none is given.
+const defaultMaskPattern = "YYMMDD-****"
+
+// Masked formats a Swedish personal identity number after a pattern, see
+// FormatLayout, with masked digits written as *. For example "YYMMDD-****"
+// gives 850709-**** and "********-NNNC" ********-9805. The pattern
+// YYMMDD-**** is used if empty.
+func (p *Personnummer) Masked(pattern string) (string, error) {
+	if pattern == "" {
+		pattern = defaultMaskPattern
+	}
+
+	return p.FormatLayout(pattern)
+}
+
+// isMask checks if the character masks a digit.
+func isMask(c byte) bool {
+	return c == '*' || c == 'X' || c == 'x'
+}
+
+// MatchesMasked checks if the number is consistent with a partially masked
+// number in the short or long format, with or without separator, where masked
+// digits are written as *, X or x. A separator in the short format must match
+// the separator of the number. Returns false if no digit is shown.
+func (p *Personnummer) MatchesMasked(masked string) bool {
+	var digits string
+	var sep byte
+
+	switch len(masked) {
+	case lengthWithoutCentury, lengthWithCentury:
+		digits = masked
+	case lengthWithoutCentury + 1:
+		digits, sep = masked[:6]+masked[7:], masked[6]
+		if p.Sep == "" || sep != p.Sep[0] {
+			return false
+		}
+	case lengthWithCentury + 1:
+		digits, sep = masked[:8]+masked[9:], masked[8]
+		if sep != '-' && sep != '+' {
+			return false
+		}
+	default:
+		return false
+	}
+
+	number := p.Century + p.Year + p.Month + p.Day + p.Num + p.Check
+	if len(number) != lengthWithCentury {
+		return false
+	}
+
+	if len(digits) == lengthWithoutCentury {
+		number = number[2:]
+	}
+
+	shown := false
+	for i := 0; i < len(digits); i++ {
+		if isMask(digits[i]) {
+			continue
+		}
+
+		if digits[i] != number[i] {
+			return false
+		}
+
+		shown = true
+	}
+
+	return shown
+}
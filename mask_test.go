@@ -0,0 +1,44 @@
+package personnummer
+
+import (
+	"testing"
+
+	"github.com/frozzare/go-assert"
+)
+
+func TestMasked(t *testing.T) {
+	p, _ := Parse("198507099805")
+
+	tests := map[string]string{
+		"":              "850709-****",
+		"YYMMDD-****":   "850709-****",
+		"********-NNNC": "********-9805",
+		"YYYYMMDD-****": "19850709-****",
+		"******-**NC":   "******-**05",
+	}
+
+	for pattern, expected := range tests {
+		v, err := p.Masked(pattern)
+		assert.Nil(t, err, pattern)
+		assert.Equal(t, expected, v, pattern)
+	}
+
+	_, err := p.Masked("YYY")
+	assert.NotNil(t, err)
+}
+
+func TestMatchesMasked(t *testing.T) {
+	p, _ := Parse("198507099805")
+
+	for _, masked := range []string{"850709-****", "******-9805", "********-9805", "19850709-****", "8507099805", "85070998**", "xxxxxx-9805", "XXXXXXXX9805", "****0709-98**"} {
+		assert.True(t, p.MatchesMasked(masked), masked)
+	}
+
+	for _, masked := range []string{"850709+****", "******-9806", "18******-****", "******-****", "************", "850709-***", "85-0709****", "850709-98O5"} {
+		assert.False(t, p.MatchesMasked(masked), masked)
+	}
+
+	old, _ := Parse("18850709-9805")
+	assert.True(t, old.MatchesMasked("850709+****"))
+	assert.False(t, old.MatchesMasked("850709-****"))
+}
@@ -0,0 +1,225 @@
+package personnummer
+
+import "fmt"
+
+// organisationsnummerCentury is the century prefix of the 12 digit form of organisation numbers.
+const organisationsnummerCentury = "16"
+
+// LegalForm represents the legal form of an organisation, given by the group number.
+type LegalForm int
+
+const (
+	// LegalFormUnknown is an unused group number.
+	LegalFormUnknown LegalForm = iota
+
+	// LegalFormEstate is the estate of a deceased person (dödsbo).
+	LegalFormEstate
+
+	// LegalFormPublic is the state, a region, a municipality or a parish.
+	LegalFormPublic
+
+	// LegalFormForeign is a foreign company operating or owning property in Sweden.
+	LegalFormForeign
+
+	// LegalFormLimitedCompany is a limited company (aktiebolag).
+	LegalFormLimitedCompany
+
+	// LegalFormSimplePartnership is a simple partnership (enkelt bolag).
+	LegalFormSimplePartnership
+
+	// LegalFormEconomicAssociation is an economic or housing association.
+	LegalFormEconomicAssociation
+
+	// LegalFormNonProfit is a non-profit association or a foundation.
+	LegalFormNonProfit
+
+	// LegalFormPartnership is a trading or limited partnership.
+	LegalFormPartnership
+
+	// LegalFormSoleTrader is a sole trader (enskild firma), using the owner's personnummer.
+	LegalFormSoleTrader
+)
+
+// legalForms maps group numbers, the first digit, to legal forms.
+var legalForms = map[byte]LegalForm{
+	'1': LegalFormEstate,
+	'2': LegalFormPublic,
+	'3': LegalFormForeign,
+	'5': LegalFormLimitedCompany,
+	'6': LegalFormSimplePartnership,
+	'7': LegalFormEconomicAssociation,
+	'8': LegalFormNonProfit,
+	'9': LegalFormPartnership,
+}
+
+// String returns the Swedish name of the legal form.
+func (l LegalForm) String() string {
+	switch l {
+	case LegalFormEstate:
+		return "Dödsbon"
+	case LegalFormPublic:
+		return "Stat, landsting, kommun eller församling"
+	case LegalFormForeign:
+		return "Utländska företag som bedriver näringsverksamhet eller äger fastigheter i Sverige"
+	case LegalFormLimitedCompany:
+		return "Aktiebolag"
+	case LegalFormSimplePartnership:
+		return "Enkelt bolag"
+	case LegalFormEconomicAssociation:
+		return "Ekonomisk förening eller bostadsrättsförening"
+	case LegalFormNonProfit:
+		return "Ideell förening och stiftelse"
+	case LegalFormPartnership:
+		return "Handelsbolag, kommanditbolag och enkelt bolag"
+	case LegalFormSoleTrader:
+		return "Enskild firma"
+	default:
+		return "Okänt"
+	}
+}
+
+// Organisationsnummer represents a Swedish organisation number.
+type Organisationsnummer struct {
+	// Number is the 10 digits of the organisation number.
+	Number string
+
+	personnummer *Personnummer
+}
+
+// ParseOrganisationsnummer parses a Swedish organisation number, with or without
+// the 16 century prefix. Numbers with a third digit less than 2 are parsed as the
+// personnummer of a sole trader, using the options.
+func ParseOrganisationsnummer(in string, options ...*Options) (*Organisationsnummer, error) {
+	digits, offset := getCleanNumber(in)
+	if offset >= 0 {
+		return nil, &ParseError{Input: in, Offset: offset, Err: ErrInvalidCharacter, subject: subjectOrganisationsnummer}
+	}
+
+	base := 0
+	if len(digits) == lengthWithCentury && string(digits[0:2]) == organisationsnummerCentury {
+		base = 2
+	} else if len(digits) != lengthWithoutCentury || digits[2] < '2' {
+		p, err := New(in, options...)
+		if err != nil {
+			return nil, err
+		}
+
+		return &Organisationsnummer{Number: p.Year + p.Month + p.Day + p.Num + p.Check, personnummer: p}, nil
+	}
+
+	digits = digits[base:]
+	for i, c := range digits {
+		if !isDigit(c) {
+			return nil, &ParseError{Input: in, Offset: inputOffset(in, base+i), Err: ErrInvalidCharacter, subject: subjectOrganisationsnummer}
+		}
+	}
+
+	if digits[2] < '2' {
+		return nil, &ParseError{Input: in, Offset: inputOffset(in, base+2), Err: ErrInvalidOrganisationsnummer, subject: subjectOrganisationsnummer}
+	}
+
+	if digits[0] == '0' {
+		return nil, &ParseError{Input: in, Offset: inputOffset(in, base), Err: ErrInvalidGroupNumber, subject: subjectOrganisationsnummer}
+	}
+
+	if !luhn(digits) {
+		return nil, &ParseError{Input: in, Offset: inputOffset(in, base+9), Err: ErrInvalidChecksum, subject: subjectOrganisationsnummer}
+	}
+
+	return &Organisationsnummer{Number: string(digits)}, nil
+}
+
+// ValidOrganisationsnummer will validate Swedish organisation numbers.
+func ValidOrganisationsnummer(in string, options ...*Options) bool {
+	_, err := ParseOrganisationsnummer(in, options...)
+	return err == nil
+}
+
+// Format a Swedish organisation number as NNNNNN-NNNN, or without
+// the separator if false is given. Sole traders are formatted as
+// the short format of their personnummer.
+func (o *Organisationsnummer) Format(separator ...bool) string {
+	if len(separator) > 0 && !separator[0] {
+		return o.Number
+	}
+
+	if o.personnummer != nil {
+		v, _ := o.personnummer.Format()
+		return v
+	}
+
+	if len(o.Number) != lengthWithoutCentury {
+		return o.Number
+	}
+
+	return fmt.Sprintf("%s-%s", o.Number[0:6], o.Number[6:])
+}
+
+// LegalForm returns the legal form of the organisation.
+func (o *Organisationsnummer) LegalForm() LegalForm {
+	if o.personnummer != nil {
+		return LegalFormSoleTrader
+	}
+
+	if o.Number == "" {
+		return LegalFormUnknown
+	}
+
+	return legalForms[o.Number[0]]
+}
+
+// IsPersonnummer checks if the organisation number is the personnummer of a sole trader.
+func (o *Organisationsnummer) IsPersonnummer() bool {
+	return o.personnummer != nil
+}
+
+// Personnummer returns the personnummer of a sole trader, or nil.
+func (o *Organisationsnummer) Personnummer() *Personnummer {
+	return o.personnummer
+}
+
+// NumberType represents the type of a parsed number.
+type NumberType int
+
+const (
+	// NumberTypePersonnummer is a Swedish personal identity number.
+	NumberTypePersonnummer NumberType = iota
+
+	// NumberTypeOrganisationsnummer is a Swedish organisation number.
+	NumberTypeOrganisationsnummer
+)
+
+// String returns the name of the number type.
+func (t NumberType) String() string {
+	switch t {
+	case NumberTypePersonnummer:
+		return "personnummer"
+	case NumberTypeOrganisationsnummer:
+		return "organisationsnummer"
+	default:
+		return "unknown"
+	}
+}
+
+// AnyNumber represents either a personal identity number or an organisation number.
+type AnyNumber struct {
+	// Type tells which of the numbers is set.
+	Type NumberType
+
+	Personnummer        *Personnummer
+	Organisationsnummer *Organisationsnummer
+}
+
+// ParseAny parses either a Swedish personal identity number or an organisation number.
+func ParseAny(in string, options ...*Options) (*AnyNumber, error) {
+	o, err := ParseOrganisationsnummer(in, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	if o.IsPersonnummer() {
+		return &AnyNumber{Type: NumberTypePersonnummer, Personnummer: o.Personnummer()}, nil
+	}
+
+	return &AnyNumber{Type: NumberTypeOrganisationsnummer, Organisationsnummer: o}, nil
+}
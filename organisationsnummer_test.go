@@ -0,0 +1,80 @@
+package personnummer
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/frozzare/go-assert"
+)
+
+func TestOrganisationsnummer(t *testing.T) {
+	for _, in := range []string{"556016-0680", "5560160680", "16556016-0680", "165560160680"} {
+		o, err := ParseOrganisationsnummer(in)
+		assert.Nil(t, err, in)
+		assert.Equal(t, "5560160680", o.Number)
+		assert.Equal(t, "556016-0680", o.Format())
+		assert.Equal(t, "5560160680", o.Format(false))
+		assert.Equal(t, LegalFormLimitedCompany, o.LegalForm())
+		assert.False(t, o.IsPersonnummer())
+		assert.Nil(t, o.Personnummer())
+	}
+
+	o, err := ParseOrganisationsnummer("802002-4181")
+	assert.Nil(t, err)
+	assert.Equal(t, LegalFormNonProfit, o.LegalForm())
+	assert.Equal(t, "Ideell förening och stiftelse", o.LegalForm().String())
+}
+
+func TestOrganisationsnummerSoleTrader(t *testing.T) {
+	o, err := ParseOrganisationsnummer("19850709-9805")
+	assert.Nil(t, err)
+	assert.True(t, o.IsPersonnummer())
+	assert.Equal(t, LegalFormSoleTrader, o.LegalForm())
+	assert.Equal(t, "8507099805", o.Number)
+	assert.Equal(t, "850709-9805", o.Format())
+	assert.Equal(t, "1985", o.Personnummer().FullYear)
+}
+
+func TestOrganisationsnummerErrors(t *testing.T) {
+	tests := map[string]error{
+		"556016-0681":   ErrInvalidChecksum,
+		"056016-0680":   ErrInvalidGroupNumber,
+		"55601-0680":    ErrInvalidLength,
+		"556016-068a":   ErrInvalidCharacter,
+		"556016-T680":   ErrInvalidCharacter,
+		"16556016-0681": ErrInvalidChecksum,
+		"850709-9806":   ErrInvalidChecksum,
+		"168507099805":  ErrInvalidOrganisationsnummer,
+	}
+
+	for in, expected := range tests {
+		_, err := ParseOrganisationsnummer(in)
+		assert.True(t, errors.Is(err, expected), in)
+		assert.False(t, ValidOrganisationsnummer(in), in)
+	}
+}
+
+func TestOrganisationsnummerErrorMessage(t *testing.T) {
+	_, err := ParseOrganisationsnummer("16556016-0681")
+	assert.Equal(t, `Invalid swedish organisation number: invalid check digit at offset 12`, err.Error())
+
+	_, err = ParseAny("168507099805")
+	assert.True(t, errors.Is(err, ErrInvalidOrganisationsnummer))
+}
+
+func TestParseAny(t *testing.T) {
+	n, err := ParseAny("556016-0680")
+	assert.Nil(t, err)
+	assert.Equal(t, NumberTypeOrganisationsnummer, n.Type)
+	assert.Equal(t, "5560160680", n.Organisationsnummer.Number)
+	assert.Nil(t, n.Personnummer)
+
+	n, err = ParseAny("850709-9805")
+	assert.Nil(t, err)
+	assert.Equal(t, NumberTypePersonnummer, n.Type)
+	assert.Equal(t, "9805", n.Personnummer.Num+n.Personnummer.Check)
+	assert.Nil(t, n.Organisationsnummer)
+
+	_, err = ParseAny("850709-9806")
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,75 @@
+package personnummer
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// defaultInterimLetter is the interim letter used by FromParts when none is given.
+const defaultInterimLetter = 'T'
+
+// PartsOptions represents the options for FromParts.
+type PartsOptions struct {
+	// Kind is the kind of number to build.
+	Kind Kind
+
+	// InterimLetter is the letter of interim numbers, T if zero.
+	InterimLetter rune
+
+	// Options are used to validate the number like New. Interim
+	// numbers are allowed when Kind is an interim kind.
+	Options *Options
+}
+
+// FromParts builds a Swedish personal identity number from the date of birth
+// and the serial number, 1-999 or 0-99 for interim numbers, computing the check
+// digit. The day is offset by 60 for coordination numbers and the separator is
+// set for the reference date. The number is validated like New.
+func FromParts(birth time.Time, serial int, opts *PartsOptions) (*Personnummer, error) {
+	if opts == nil {
+		opts = &PartsOptions{}
+	}
+
+	if opts.Kind < KindOrdinary || opts.Kind > KindInterimCoordination {
+		return nil, ErrInvalidKind
+	}
+
+	o := *optionsOf(opts.Options)
+
+	interim := opts.Kind == KindInterim || opts.Kind == KindInterimCoordination
+	coordination := opts.Kind == KindCoordination || opts.Kind == KindInterimCoordination
+
+	var num string
+	if interim {
+		letter := opts.InterimLetter
+		if letter == 0 {
+			letter = defaultInterimLetter
+		}
+
+		if !runeInSlice(letter, interimLetters) {
+			return nil, &ParseError{Input: string(letter), Offset: 0, Err: ErrInvalidCharacter}
+		}
+
+		if serial < 0 || serial > 99 {
+			return nil, &ParseError{Input: strconv.Itoa(serial), Offset: -1, Err: ErrInvalidSerial}
+		}
+
+		num = fmt.Sprintf("%c%02d", letter, serial)
+		o.AllowInterimNumber = true
+	} else {
+		if serial == 0 {
+			return nil, &ParseError{Input: strconv.Itoa(serial), Offset: -1, Err: ErrZeroSerial}
+		}
+
+		if serial < 0 || serial > 999 {
+			return nil, &ParseError{Input: strconv.Itoa(serial), Offset: -1, Err: ErrInvalidSerial}
+		}
+
+		num = fmt.Sprintf("%03d", serial)
+	}
+
+	v, _ := fromParts(calendarDate(birth), num, coordination, &o).Format(true)
+
+	return New(v, &o)
+}
@@ -0,0 +1,73 @@
+package personnummer
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/frozzare/go-assert"
+)
+
+func TestFromParts(t *testing.T) {
+	birth := time.Date(1985, 7, 9, 15, 30, 0, 0, time.UTC)
+
+	p, err := FromParts(birth, 980, nil)
+	assert.Nil(t, err)
+	v, _ := p.Format()
+	assert.Equal(t, "850709-9805", v)
+
+	q, _ := Parse("850709-9805")
+	assert.Equal(t, q, p)
+
+	p, err = FromParts(birth, 980, &PartsOptions{Kind: KindCoordination})
+	assert.Nil(t, err)
+	v, _ = p.Format()
+	assert.Equal(t, "850769-9802", v)
+
+	p, err = FromParts(birth, 22, &PartsOptions{Kind: KindInterim})
+	assert.Nil(t, err)
+	v, _ = p.Format()
+	assert.Equal(t, "850709-T224", v)
+
+	p, err = FromParts(birth, 22, &PartsOptions{Kind: KindInterimCoordination, InterimLetter: 'T'})
+	assert.Nil(t, err)
+	assert.Equal(t, KindInterimCoordination, p.Kind())
+
+	p, err = FromParts(time.Date(1885, 7, 9, 0, 0, 0, 0, time.UTC), 980, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "+", p.Sep)
+
+	p, err = FromParts(time.Date(1885, 7, 9, 0, 0, 0, 0, time.UTC), 980, &PartsOptions{Options: &Options{ReferenceDate: time.Date(1980, 1, 1, 0, 0, 0, 0, time.UTC)}})
+	assert.Nil(t, err)
+	assert.Equal(t, "-", p.Sep)
+}
+
+func TestFromPartsErrors(t *testing.T) {
+	birth := time.Date(1985, 7, 9, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		serial  int
+		options *PartsOptions
+		err     error
+	}{
+		{0, nil, ErrZeroSerial},
+		{1000, nil, ErrInvalidSerial},
+		{-1, nil, ErrInvalidSerial},
+		{100, &PartsOptions{Kind: KindInterim}, ErrInvalidSerial},
+		{10, &PartsOptions{Kind: KindInterim, InterimLetter: 'A'}, ErrInvalidCharacter},
+		{980, &PartsOptions{Kind: KindCoordination, Options: &Options{DisableCoordinationNumber: true}}, ErrCoordinationNumberNotAllowed},
+		{980, &PartsOptions{Kind: Kind(4)}, ErrInvalidKind},
+		{980, &PartsOptions{Kind: Kind(-1)}, ErrInvalidKind},
+	}
+
+	for _, test := range tests {
+		_, err := FromParts(birth, test.serial, test.options)
+		assert.True(t, errors.Is(err, test.err), test.serial)
+	}
+}
+
+func TestFromPartsNilOptions(t *testing.T) {
+	p, err := FromParts(time.Date(1985, 7, 9, 0, 0, 0, 0, time.UTC), 980, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, KindOrdinary, p.Kind())
+}
@@ -1,10 +1,7 @@
 package personnummer
 
 import (
-	"errors"
 	"fmt"
-	"math"
-	"strconv"
 	"strings"
 	"time"
 	"unicode"
@@ -16,8 +13,7 @@ const (
 )
 
 var (
-	errInvalidSecurityNumber = errors.New("Invalid swedish personal identity number")
-	monthDays                = map[int]int{
+	monthDays = map[int]int{
 		1:  31,
 		3:  31,
 		4:  30,
@@ -37,15 +33,10 @@ var (
 
 // charsToDigit converts char bytes to a digit
 // example: ['1', '1'] => 11
-func charsToDigit(chars []byte) int {
-	l := len(chars)
+func charsToDigit[T string | []byte](chars T) int {
 	r := 0
-	for i, c := range chars {
-		p := int((c - '0'))
-		for j := 0; j < l-i-1; j++ {
-			p *= 10
-		}
-		r += p
+	for i := 0; i < len(chars); i++ {
+		r = r*10 + int(chars[i]-'0')
 	}
 	return r
 }
@@ -60,10 +51,12 @@ func runeInSlice(a rune, list []rune) bool {
 }
 
 // getCleanNumber will return clean numbers.
-func getCleanNumber(in string) []byte {
+// If the input contains an invalid character the byte offset
+// of that character is returned as well, otherwise -1.
+func getCleanNumber(in string) ([]byte, int) {
 	cleanNumber := make([]byte, 0, len(in))
 
-	for _, c := range in {
+	for i, c := range in {
 		if c == '+' {
 			continue
 		}
@@ -73,36 +66,33 @@ func getCleanNumber(in string) []byte {
 
 		if !runeInSlice(c, interimLetters) {
 			if c > '9' {
-				return nil
+				return nil, i
 			}
 			if c < '0' {
-				return nil
+				return nil, i
 			}
 		}
 
 		cleanNumber = append(cleanNumber, byte(c))
 	}
 
-	return cleanNumber
+	return cleanNumber, -1
 }
 
-// getCoOrdinationDay will return co-ordination day.
-func getCoOrdinationDay(day []byte) []byte {
-	d := charsToDigit(day)
-	if d < 60 {
-		return day
-	}
-
-	d -= 60
-
-	if d < 10 {
-		return []byte{'0', byte(d) + '0'}
+// inputOffset returns the byte offset in the input of the
+// character at the given index of the clean number.
+func inputOffset(in string, index int) int {
+	for i, c := range in {
+		if c == '+' || c == '-' {
+			continue
+		}
+		if index == 0 {
+			return i
+		}
+		index--
 	}
 
-	return []byte{
-		byte(d)/10 + '0',
-		byte(d)%10 + '0',
-	}
+	return -1
 }
 
 // luhn will test if the given string is a valid luhn string.
@@ -128,41 +118,62 @@ func luhn(s []byte) bool {
 	return sum%10 == 0
 }
 
-// toString converts int to string.
-func toString(in interface{}) string {
-	switch v := in.(type) {
-	case int, int32, int64, uint, uint32, uint64:
-		return fmt.Sprint(v)
-	case string:
-		return v
-	default:
-		return ""
+// luhnCheckDigit returns the digit that makes the given digits followed
+// by it a valid luhn string.
+func luhnCheckDigit(s []byte) byte {
+	odd := (len(s) + 1) & 1
+
+	var sum int
+
+	for i, c := range s {
+		if i&1 == odd {
+			sum += rule3[c-'0']
+		} else {
+			sum += int(c - '0')
+		}
 	}
+
+	return byte((10-sum%10)%10) + '0'
 }
 
-// input time without centry.
-func validateTime(time []byte) bool {
-	length := len(time)
+// digitStrings holds the numbers 0000 to 9999 as four digits, sliced
+// by digitString to fill the fields of a number without allocating.
+var digitStrings = func() string {
+	var b strings.Builder
+	b.Grow(4 * 10000)
+	for i := 0; i < 10000; i++ {
+		b.WriteByte(byte(i/1000) + '0')
+		b.WriteByte(byte(i/100%10) + '0')
+		b.WriteByte(byte(i/10%10) + '0')
+		b.WriteByte(byte(i%10) + '0')
+	}
+	return b.String()
+}()
+
+// digitString returns the last width digits of v, which must be
+// below 10000, zero padded.
+func digitString(v, width int) string {
+	end := 4*v + 4
+	return digitStrings[end-width : end]
+}
 
-	date := charsToDigit(time[length-2 : length])
-	month := charsToDigit(time[length-4 : length-2])
+// isInterimLetter checks if c is a letter used in interim numbers.
+func isInterimLetter(c byte) bool {
+	return strings.IndexByte("TRSUWXJKLMN", c) >= 0
+}
 
+// daysInMonth returns the number of days in the month of the given
+// year, or 0 if the month does not exist.
+func daysInMonth(year, month int) int {
 	if month != 2 {
-		days, ok := monthDays[month]
-		if !ok {
-			return false
-		}
-		return date <= days
+		return monthDays[month]
 	}
 
-	year := charsToDigit(time[:length-4])
-
-	leapYear := year%4 == 0 && year%100 != 0 || year%400 == 0
-
-	if leapYear {
-		return date <= 29
+	if year%4 == 0 && year%100 != 0 || year%400 == 0 {
+		return 29
 	}
-	return date <= 28
+
+	return 28
 }
 
 // Personnummer represents the personnummer struct.
@@ -177,157 +188,417 @@ type Personnummer struct {
 	Check              string
 	leapYear           bool
 	coordinationNumber bool
+	normalization      Normalization
+
+	// options are the options the number was created with.
+	options Options
 }
 
 // Options represents the personnummer options.
 type Options struct {
 	AllowInterimNumber        bool
 	DisableCoordinationNumber bool
+
+	// ReferenceDate is the date used to infer the century of numbers
+	// without one, to choose the separator and to calculate the age.
+	// The zero value means the current time.
+	ReferenceDate time.Time
+
+	// DisableSexInference makes Sex return SexUnknown for every number.
+	DisableSexInference bool
+
+	// TextFormat is the format used when marshalling the number.
+	TextFormat TextFormat
+
+	// Lenient normalises the input before parsing, see Normalize.
+	Lenient bool
+
+	// Strict only accepts the official layouts YYMMDD-NNNC, YYMMDD+NNNC,
+	// YYYYMMDDNNNC and YYYYMMDD-NNNC, and YYYYMMDD+NNNC for people that
+	// have turned 100. Lenient input is normalised first.
+	Strict bool
+}
+
+// referenceDate returns the reference date or the current time.
+func (o *Options) referenceDate() time.Time {
+	if o.ReferenceDate.IsZero() {
+		return now()
+	}
+
+	return o.ReferenceDate
 }
 
 // New parse a Swedish personal identity numbers and returns a new struct or a error.
 func New(pin string, options ...*Options) (*Personnummer, error) {
 	p := &Personnummer{}
-	o := &Options{}
 
-	if len(options) > 0 {
-		o = options[0]
-	}
-
-	if err := p.parse(pin, o); err != nil {
+	if err := p.parse(pin, optionsOf(options...)); err != nil {
 		return nil, err
 	}
 
 	return p, nil
 }
 
-// parse Swedish personal identity numbers and set struct properpties or return a error.
-func (p *Personnummer) parse(pin string, options *Options) error {
-	var century, year, num, check string
-
-	if pin == "" {
-		return errInvalidSecurityNumber
+// optionsOf returns the first of the given options or the default options.
+func optionsOf(options ...*Options) *Options {
+	if len(options) > 0 && options[0] != nil {
+		return options[0]
 	}
 
-	dateBytes := getCleanNumber(pin)
+	return &Options{}
+}
+
+// number is a Swedish personal identity number as digits in the long format,
+// YYYYMMDDNNNC, kept on the stack while validating.
+type number struct {
+	digits [lengthWithCentury]byte
+
+	// offsets are the byte offsets in the input of the digits,
+	// -1 for the century of numbers without one.
+	offsets [lengthWithCentury]int
+
+	short bool
+	plus  bool
+
+	// separators are the byte offsets in the input of the
+	// first two of the separatorCount separators.
+	separators     [2]int
+	separatorCount int
+}
+
+// scanNumber reads the digits of in into n. It returns the byte offset
+// of an invalid character, or -1, with the error.
+func scanNumber[T string | []byte](in T, n *number) (int, error) {
+	count := 0
+
+	for i := 0; i < len(in); i++ {
+		c := in[i]
 
-	switch len(dateBytes) {
+		switch {
+		case c == '+' || c == '-':
+			if n.separatorCount < len(n.separators) {
+				n.separators[n.separatorCount] = i
+			}
+			n.separatorCount++
+			n.plus = n.plus || c == '+'
+			continue
+		case !isDigit(c) && !isInterimLetter(c):
+			return i, ErrInvalidCharacter
+		}
+
+		if count < lengthWithCentury {
+			n.digits[count] = c
+			n.offsets[count] = i
+		}
+		count++
+	}
+
+	switch count {
 	case lengthWithCentury:
-		century = string(dateBytes[0:2])
-		year = string(dateBytes[2:4])
-		num = string(dateBytes[8:11])
-		check = string(dateBytes[11:])
-		dateBytes = dateBytes[2:8]
-		break
 	case lengthWithoutCentury:
-		year = string(dateBytes[0:2])
-		num = string(dateBytes[6:9])
-		check = string(dateBytes[9:])
-		dateBytes = dateBytes[0:6]
-		break
+		copy(n.digits[2:], n.digits[:lengthWithoutCentury])
+		copy(n.offsets[2:], n.offsets[:lengthWithoutCentury])
+		n.offsets[0], n.offsets[1] = -1, -1
+		n.short = true
 	default:
-		return errInvalidSecurityNumber
+		return -1, ErrInvalidLength
 	}
 
-	if num == "000" {
-		return errInvalidSecurityNumber
+	return -1, nil
+}
+
+// validate checks the digits of n, inferring the century of numbers without
+// one from the reference date. It returns the index in the long format of the
+// offending digit, or -1, with the error.
+func (n *number) validate(options *Options, ref time.Time) (int, error) {
+	start := 0
+	if n.short {
+		start = 2
 	}
 
-	length := len(dateBytes)
-	day := charsToDigit(dateBytes[length-2 : length])
-	month := charsToDigit(dateBytes[length-4 : length-2])
+	for i := start; i < lengthWithCentury; i++ {
+		if !isDigit(n.digits[i]) && i != 8 {
+			return i, ErrInvalidCharacter
+		}
+	}
 
-	if month != 2 {
-		if _, ok := monthDays[month]; !ok {
-			return errInvalidSecurityNumber
+	if n.digits[8] == '0' && n.digits[9] == '0' && n.digits[10] == '0' {
+		return 8, ErrZeroSerial
+	}
+
+	month := charsToDigit(n.digits[4:6])
+	if month < 1 || month > 12 {
+		return 4, ErrInvalidMonth
+	}
+
+	if n.short {
+		baseYear := ref.Year()
+		if n.plus {
+			baseYear -= 100
 		}
+
+		fullYear := baseYear - (baseYear-charsToDigit(n.digits[2:4]))%100
+		n.digits[0] = byte(fullYear/1000%10) + '0'
+		n.digits[1] = byte(fullYear/100%10) + '0'
+	}
+
+	day := charsToDigit(n.digits[6:8])
+	coordination := day >= 60
+	if coordination {
+		day -= 60
 	}
 
-	plus := strings.Contains(pin, "+")
+	if day > daysInMonth(charsToDigit(n.digits[0:4]), month) {
+		return 6, ErrInvalidDay
+	}
 
-	p.Century = century
-	p.Year = year
-	p.FullYear = toString(century + year)
-	p.Check = check
-	p.Num = num
-	p.Sep = "-"
-	p.Day = toString(fmt.Sprintf("%02d", day))
-	p.Month = toString(fmt.Sprintf("%02d", month))
+	interim := isInterimLetter(n.digits[8])
 
-	if p.Century == "" {
-		year := charsToDigit(dateBytes[:length-4])
+	check := n.digits
+	if interim {
+		check[8] = '1'
+	}
 
-		var baseYear int
-		if plus {
-			baseYear = now().Year() - 100
-			p.Sep = "+"
-		} else {
-			baseYear = now().Year()
+	if !luhn(check[2:]) {
+		return 11, ErrInvalidChecksum
+	}
+
+	if coordination && options.DisableCoordinationNumber {
+		return 6, ErrCoordinationNumberNotAllowed
+	}
+
+	if interim && !options.AllowInterimNumber {
+		return 8, ErrInterimNumberNotAllowed
+	}
+
+	return -1, nil
+}
+
+// strict checks that n was written in one of the official layouts, with a
+// '+' in the long format only for people that have turned 100 at the reference
+// date. It returns the byte offset in the input of the offending character,
+// or -1, with the error.
+func (n *number) strict(ref time.Time) (int, error) {
+	switch {
+	case n.separatorCount == 0 && n.short:
+		return n.offsets[8], ErrInvalidSeparator
+	case n.separatorCount == 0:
+		return -1, nil
+	case n.separators[0] != n.offsets[7]+1:
+		return n.separators[0], ErrInvalidSeparator
+	case n.separatorCount > 1:
+		return n.separators[1], ErrInvalidSeparator
+	case n.plus && !n.short && separatorAt(charsToDigit(n.digits[0:4]), ref) != "+":
+		return n.separators[0], ErrInvalidSeparator
+	}
+
+	return -1, nil
+}
+
+// field returns the digits of n from start to end as a string, sliced from
+// the input or from digitStrings when possible.
+func (n *number) field(pin string, start, end int) string {
+	first, last := n.offsets[start], n.offsets[end-1]
+	if first >= 0 && last-first == end-1-start {
+		return pin[first : last+1]
+	}
+
+	for _, c := range n.digits[start:end] {
+		if !isDigit(c) {
+			return string(n.digits[start:end])
 		}
+	}
 
-		centuryStr := strconv.Itoa((baseYear - ((baseYear - year) % 100)))
-		century, err := strconv.Atoi(centuryStr[0:2])
-		if err != nil {
-			return err
+	return digitString(charsToDigit(n.digits[start:end]), end-start)
+}
+
+// parse Swedish personal identity numbers and set struct properpties or return a error.
+func (p *Personnummer) parse(pin string, options *Options) error {
+	var n number
+	var norm Normalization
+
+	input := pin
+	if options.Lenient {
+		pin, norm = Normalize(input)
+	}
+
+	if offset, err := scanNumber(pin, &n); err != nil {
+		return parseError(input, norm, offset, err)
+	}
+
+	ref := options.referenceDate()
+
+	if index, err := n.validate(options, ref); err != nil {
+		return parseError(input, norm, n.offsets[index], err)
+	}
+
+	if options.Strict {
+		if offset, err := n.strict(ref); err != nil {
+			return parseError(input, norm, offset, err)
 		}
+	}
 
-		p.Century = toString(century)
-		p.FullYear = toString(p.Century + p.Year)
-	} else {
-		fullYear, err := strconv.Atoi(century + year)
-		if err != nil {
-			return err
+	*p = Personnummer{
+		Century:       n.field(pin, 0, 2),
+		FullYear:      n.field(pin, 0, 4),
+		Year:          n.field(pin, 2, 4),
+		Month:         n.field(pin, 4, 6),
+		Day:           n.field(pin, 6, 8),
+		Num:           n.field(pin, 8, 11),
+		Check:         n.field(pin, 11, 12),
+		normalization: norm,
+		options:       *options,
+	}
+
+	switch {
+	case !n.short:
+		p.Sep = separatorAt(charsToDigit(n.digits[0:4]), ref)
+	case n.plus:
+		p.Sep = "+"
+	default:
+		p.Sep = "-"
+	}
+
+	return nil
+}
+
+// Validate checks that the fields of a Swedish personal identity number are
+// consistent, for numbers that were not returned by New: the century and year
+// match the full year, the date exists, the check digit matches and the
+// separator matches the age at the reference date of the options, or the one
+// the number was parsed with. Errors are *ParseError with offsets in the long
+// format, YYYYMMDDNNNC.
+func (p *Personnummer) Validate(options *Options) error {
+	if options == nil {
+		options = &Options{}
+	}
+
+	long := p.Century + p.Year + p.Month + p.Day + p.Num + p.Check
+
+	fail := func(err error, offset int) error {
+		return &ParseError{Input: long, Offset: offset, Err: err}
+	}
+
+	fields := []struct {
+		value  string
+		length int
+		offset int
+	}{
+		{p.Century, 2, 0},
+		{p.Year, 2, 2},
+		{p.Month, 2, 4},
+		{p.Day, 2, 6},
+		{p.Num, 3, 8},
+		{p.Check, 1, 11},
+	}
+
+	for _, f := range fields {
+		if len(f.value) != f.length {
+			return fail(ErrInvalidLength, -1)
 		}
 
-		if now().Year()-fullYear < 100 {
-			p.Sep = "-"
-		} else {
-			p.Sep = "+"
+		for i := 0; i < len(f.value); i++ {
+			if isDigit(f.value[i]) || f.offset == 8 && i == 0 && runeInSlice(rune(f.value[i]), interimLetters) {
+				continue
+			}
+
+			return fail(ErrInvalidCharacter, f.offset+i)
 		}
 	}
 
-	if !p.valid() {
-		return errInvalidSecurityNumber
+	if p.FullYear != p.Century+p.Year {
+		return fail(ErrInvalidCentury, 0)
+	}
+
+	ref := options.ReferenceDate
+	if ref.IsZero() {
+		ref = p.options.ReferenceDate
+	}
+	if ref.IsZero() {
+		ref = now()
 	}
 
-	if p.IsCoordinationNumber() && options.DisableCoordinationNumber {
-		return errInvalidSecurityNumber
+	var n number
+	copy(n.digits[:], long)
+
+	if index, err := n.validate(options, ref); err != nil {
+		return fail(err, index)
 	}
 
-	if p.IsInterimNumber() && !options.AllowInterimNumber {
-		return errInvalidSecurityNumber
+	if p.Sep != separatorAt(charsToDigit(p.FullYear), ref) {
+		return fail(ErrInvalidSeparator, -1)
 	}
 
 	return nil
 }
 
-// Valid will validate Swedish personal identity numbers.
-func (p *Personnummer) valid() bool {
+// fromParts returns a Swedish personal identity number for the given date of
+// birth and serial number with the check digit computed. The day is offset
+// by 60 for coordination numbers.
+func fromParts(birth time.Time, num string, coordination bool, options *Options) *Personnummer {
+	day := birth.Day()
+	if coordination {
+		day += 60
+	}
+
+	fullYear := fmt.Sprintf("%04d", birth.Year())
+
+	p := &Personnummer{
+		Century:  fullYear[0:2],
+		FullYear: fullYear,
+		Year:     fullYear[2:],
+		Month:    fmt.Sprintf("%02d", int(birth.Month())),
+		Day:      fmt.Sprintf("%02d", day),
+		Sep:      separatorAt(birth.Year(), options.referenceDate()),
+		Num:      num,
+		options:  *options,
+	}
+
+	p.Check = string(p.checkDigit())
+
+	return p
+}
+
+// checkDigit returns the check digit computed from the other digits.
+func (p *Personnummer) checkDigit() byte {
 	num := p.Num
 	if p.IsInterimNumber() {
 		num = "1" + p.Num[1:]
 	}
 
-	pin := fmt.Sprintf("%s%s%s%s%s%s", p.Century, p.Year, p.Month, p.Day, num, p.Check)
+	return luhnCheckDigit([]byte(p.Year + p.Month + p.Day + num))
+}
 
-	bytes := []byte(pin)
-	if !luhn(bytes[2:]) {
-		return false
+// separatorAt returns the separator for a person born in the given
+// year at the given time, "+" for people that have turned 100.
+func separatorAt(fullYear int, t time.Time) string {
+	if t.Year()-fullYear < 100 {
+		return "-"
 	}
 
-	var dateBytes = append(bytes[:6], getCoOrdinationDay(bytes[6:8])...)
-
-	return validateTime(dateBytes)
+	return "+"
 }
 
 // Format a Swedish personal identity number as one of the official formats,
 // a long format or a short format.
 func (p *Personnummer) Format(longFormat ...bool) (string, error) {
 	if len(longFormat) > 0 && longFormat[0] {
-		return fmt.Sprintf("%s%s%s%s%s%s", p.Century, p.Year, p.Month, p.Day, p.Num, p.Check), nil
+		return p.Century + p.Year + p.Month + p.Day + p.Num + p.Check, nil
 	}
 
-	return fmt.Sprintf("%s%s%s%s%s%s", p.Year, p.Month, p.Day, p.Sep, p.Num, p.Check), nil
+	return p.Year + p.Month + p.Day + p.Sep + p.Num + p.Check, nil
+}
+
+// FormatAt formats a Swedish personal identity number like Format but
+// with the separator of the short format as of the given time.
+func (p *Personnummer) FormatAt(t time.Time, longFormat ...bool) (string, error) {
+	if len(longFormat) > 0 && longFormat[0] {
+		return p.Format(true)
+	}
+
+	sep := separatorAt(charsToDigit([]byte(p.FullYear)), t)
+
+	return p.Year + p.Month + p.Day + sep + p.Num + p.Check, nil
 }
 
 // GetDate returns the date from a Swedish personal identity number.
@@ -344,50 +615,174 @@ func (p *Personnummer) GetDate() time.Time {
 	return time.Date(fullYear, time.Month(month), ageDay, 0, 0, 0, 0, time.UTC)
 }
 
-// GetAge returns the age from a Swedish personal identity number.
-func (p *Personnummer) GetAge() int {
-	t := p.GetDate()
-	a := math.Floor(float64(now().Sub(t)/1e6) / 3.15576e+10)
-
-	return int(a)
-}
-
 // IsCoordinationNumber determine if a Swedish personal identity number is a coordination number or not.
 // Returns true if it's a coordination number.
 func (p *Personnummer) IsCoordinationNumber() bool {
-	day := charsToDigit([]byte(p.Day)) - 60
-	str := fmt.Sprintf("%s%s%s", p.Century, p.Year, p.Month)
-	if day < 10 {
-		str += fmt.Sprintf("0%d", day)
-	} else {
-		str += fmt.Sprintf("%d", day)
+	if len(p.Day) != 2 || len(p.Month) != 2 {
+		return false
+	}
+
+	day := charsToDigit(p.Day) - 60
+	if day < 0 {
+		return false
 	}
-	return validateTime([]byte(str))
+
+	year := charsToDigit(p.Century)*100 + charsToDigit(p.Year)
+
+	return day <= daysInMonth(year, charsToDigit(p.Month))
 }
 
 // IsInterimNumber determine if a Swedish personal identity number is a interim number or not.
 // Returns true if it's a interim number.
 func (p *Personnummer) IsInterimNumber() bool {
-	return runeInSlice(rune(p.Num[0]), interimLetters)
+	if len(p.Num) == 0 {
+		return false
+	}
+
+	return isInterimLetter(p.Num[0])
+}
+
+// Kind represents the kind of a Swedish personal identity number.
+type Kind int
+
+const (
+	// KindOrdinary is an ordinary personal identity number.
+	KindOrdinary Kind = iota
+
+	// KindCoordination is a coordination number (samordningsnummer).
+	KindCoordination
+
+	// KindInterim is an interim number (interimsnummer).
+	KindInterim
+
+	// KindInterimCoordination is an interim number with a coordination day.
+	KindInterimCoordination
+)
+
+// String returns the name of the kind.
+func (k Kind) String() string {
+	switch k {
+	case KindOrdinary:
+		return "ordinary"
+	case KindCoordination:
+		return "coordination"
+	case KindInterim:
+		return "interim"
+	case KindInterimCoordination:
+		return "interim coordination"
+	default:
+		return "unknown"
+	}
+}
+
+// Kind returns the kind of a Swedish personal identity number.
+func (p *Personnummer) Kind() Kind {
+	switch {
+	case p.IsInterimNumber() && p.IsCoordinationNumber():
+		return KindInterimCoordination
+	case p.IsInterimNumber():
+		return KindInterim
+	case p.IsCoordinationNumber():
+		return KindCoordination
+	default:
+		return KindOrdinary
+	}
+}
+
+// Sex represents the sex of a Swedish personal identity number.
+type Sex int
+
+const (
+	// SexUnknown is used when the sex is not known.
+	SexUnknown Sex = iota
+
+	// SexMale is male, an odd third digit of the serial number.
+	SexMale
+
+	// SexFemale is female, an even third digit of the serial number.
+	SexFemale
+)
+
+// String returns the name of the sex.
+func (s Sex) String() string {
+	switch s {
+	case SexMale:
+		return "male"
+	case SexFemale:
+		return "female"
+	default:
+		return "unknown"
+	}
+}
+
+// Sex returns the sex of a Swedish personal identity number, given by the third
+// digit of the serial number. The sex is unknown for interim numbers and when
+// parsed with Options.DisableSexInference.
+func (p *Personnummer) Sex() Sex {
+	if p.options.DisableSexInference || p.IsInterimNumber() || len(p.Num) < 3 {
+		return SexUnknown
+	}
+
+	if int(p.Num[2])%2 == 1 {
+		return SexMale
+	}
+
+	return SexFemale
 }
 
 // IsFemale checks if a Swedish personal identity number is for a female.
 func (p *Personnummer) IsFemale() bool {
-	return !p.IsMale()
+	return p.Sex() == SexFemale
 }
 
 // IsMale checks if a Swedish personal identity number is for a male.
-// The second argument should be a boolean
 func (p *Personnummer) IsMale() bool {
-	sexDigit := int(p.Num[2])
-
-	return sexDigit%2 == 1
+	return p.Sex() == SexMale
 }
 
 // Valid will validate Swedish personal identity numbers
 func Valid(pin string, options ...*Options) bool {
-	_, err := Parse(pin, options...)
-	return err == nil
+	return valid(pin, optionsOf(options...))
+}
+
+// ValidBytes will validate Swedish personal identity numbers like Valid,
+// without converting the input to a string.
+func ValidBytes(pin []byte, options ...*Options) bool {
+	return valid(pin, optionsOf(options...))
+}
+
+// valid checks a Swedish personal identity number without allocating.
+func valid[T string | []byte](pin T, options *Options) bool {
+	var n number
+
+	if options.Lenient {
+		s, _ := Normalize(string(pin))
+		pin = T(s)
+	}
+
+	if _, err := scanNumber(pin, &n); err != nil {
+		return false
+	}
+
+	ref := options.referenceDate()
+
+	if _, err := n.validate(options, ref); err != nil {
+		return false
+	}
+
+	if options.Strict {
+		_, err := n.strict(ref)
+		return err == nil
+	}
+
+	return true
+}
+
+// ParseInto parses a Swedish personal identity number into p, reusing it
+// to avoid allocations. The fields of p share memory with pin. On error p
+// is left unchanged.
+func ParseInto(p *Personnummer, pin string, options ...*Options) error {
+	return p.parse(pin, optionsOf(options...))
 }
 
 // Parse Swedish personal identity numbers and return a new struct.
@@ -1,11 +1,12 @@
 package personnummer
 
 import (
+	"errors"
 	"fmt"
 	"log"
-	"math"
 	"os"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -173,7 +174,11 @@ func TestPersonnummerAge(t *testing.T) {
 		}
 
 		tt, _ := time.Parse("2006-01-02", fmt.Sprintf("%s-%s-%s", year, month, day))
-		a := math.Floor(float64(now().Sub(tt)/1e6) / 3.15576e+10)
+		n := now()
+		a := n.Year() - tt.Year()
+		if n.Month() < tt.Month() || n.Month() == tt.Month() && n.Day() < tt.Day() {
+			a--
+		}
 
 		for _, format := range availableListFormats {
 			if format == "short_format" {
@@ -241,3 +246,353 @@ func BenchmarkValid(b *testing.B) {
 		Valid(testList[0].LongFormat)
 	}
 }
+
+func BenchmarkValidShort(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		Valid("850709-9805")
+	}
+}
+
+func BenchmarkValidLong(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		Valid("198507099805")
+	}
+}
+
+func BenchmarkValidInvalid(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		Valid("850709-9806")
+	}
+}
+
+func BenchmarkValidBytes(b *testing.B) {
+	pin := []byte("198507099805")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		ValidBytes(pin)
+	}
+}
+
+func BenchmarkParse(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = Parse("850709-9805")
+	}
+}
+
+func BenchmarkParseInto(b *testing.B) {
+	var p Personnummer
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = ParseInto(&p, "850709-9805")
+	}
+}
+
+func BenchmarkFormat(b *testing.B) {
+	p, _ := Parse("850709-9805")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = p.Format(true)
+	}
+}
+
+func TestValidBytes(t *testing.T) {
+	for _, in := range []string{"850709-9805", "198507099805", "850769-9802", "20040229-1231"} {
+		assert.True(t, ValidBytes([]byte(in)), in)
+	}
+
+	for _, in := range []string{"", "850709-9806", "850709-T224", "850709 9805"} {
+		assert.False(t, ValidBytes([]byte(in)), in)
+	}
+
+	assert.True(t, ValidBytes([]byte("850709-T224"), &Options{AllowInterimNumber: true}))
+}
+
+func TestParseInto(t *testing.T) {
+	var p Personnummer
+
+	for _, in := range []string{"850709-9805", "198507099805", "850769-9802", "20040229-1231", "8507-09-9805", "850709+9805"} {
+		expected, err := Parse(in)
+		assert.Nil(t, err, in)
+		assert.Nil(t, ParseInto(&p, in), in)
+		assert.Equal(t, *expected, p, in)
+	}
+
+	assert.Equal(t, "1885", p.FullYear)
+
+	err := ParseInto(&p, "850709-9806")
+	assert.True(t, errors.Is(err, ErrInvalidChecksum))
+	assert.Equal(t, "18850709", p.FullYear+p.Month+p.Day)
+}
+
+func TestAllocations(t *testing.T) {
+	var p Personnummer
+	pin := []byte("850709-9805")
+	options := &Options{ReferenceDate: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	tests := map[string]func(){
+		"Valid short":       func() { Valid("850709-9805") },
+		"Valid long":        func() { Valid("198507099805") },
+		"Valid invalid":     func() { Valid("850709-9806") },
+		"Valid options":     func() { Valid("850709+9805", options) },
+		"ValidBytes":        func() { ValidBytes(pin) },
+		"ParseInto short":   func() { _ = ParseInto(&p, "850709-9805") },
+		"ParseInto long":    func() { _ = ParseInto(&p, "19850709-9805", options) },
+		"ParseInto interim": func() { _ = ParseInto(&p, "850709-T224", &Options{AllowInterimNumber: true}) },
+		"IsCoordination":    func() { p.IsCoordinationNumber() },
+	}
+
+	for name, f := range tests {
+		assert.Equal(t, float64(0), testing.AllocsPerRun(100, f), name)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		input   string
+		options *Options
+		err     error
+		offset  int
+	}{
+		{"", nil, ErrInvalidLength, -1},
+		{"19850709980", nil, ErrInvalidLength, -1},
+		{"8507a9-9805", nil, ErrInvalidCharacter, 4},
+		{"850709-0001", nil, ErrZeroSerial, 7},
+		{"19851309-9805", nil, ErrInvalidMonth, 4},
+		{"850732-9805", nil, ErrInvalidDay, 4},
+		{"198507099806", nil, ErrInvalidChecksum, 11},
+		{"850709-9806", nil, ErrInvalidChecksum, 10},
+		{"850769-9802", &Options{DisableCoordinationNumber: true}, ErrCoordinationNumberNotAllowed, 4},
+		{"850709-T224", nil, ErrInterimNumberNotAllowed, 7},
+	}
+
+	for _, test := range tests {
+		var options []*Options
+		if test.options != nil {
+			options = append(options, test.options)
+		}
+
+		_, err := Parse(test.input, options...)
+		assert.True(t, errors.Is(err, test.err), test.input)
+
+		var perr *ParseError
+		assert.True(t, errors.As(err, &perr), test.input)
+		assert.Equal(t, test.input, perr.Input)
+		assert.Equal(t, test.offset, perr.Offset, test.input)
+	}
+}
+
+func TestParseErrorMessage(t *testing.T) {
+	_, err := Parse("850709-9806")
+	assert.Equal(t, "Invalid swedish personal identity number: invalid check digit at offset 10", err.Error())
+	assert.False(t, strings.Contains(err.Error(), "850709"))
+
+	_, err = Parse("")
+	assert.Equal(t, "Invalid swedish personal identity number: invalid length", err.Error())
+}
+
+func TestReferenceDate(t *testing.T) {
+	ref := time.Date(1995, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	p, err := Parse("200101-1234", &Options{ReferenceDate: ref})
+	assert.Nil(t, err)
+	assert.Equal(t, "1920", p.FullYear)
+	assert.Equal(t, 75, p.GetAge())
+
+	p, err = Parse("200101-1234", &Options{ReferenceDate: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)})
+	assert.Nil(t, err)
+	assert.Equal(t, "2020", p.FullYear)
+
+	p, err = Parse("19200101-1234", &Options{ReferenceDate: ref})
+	assert.Nil(t, err)
+	assert.Equal(t, "-", p.Sep)
+
+	v, _ := p.FormatAt(ref)
+	assert.Equal(t, "200101-1234", v)
+
+	v, _ = p.FormatAt(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	assert.Equal(t, "200101+1234", v)
+
+	v, _ = p.FormatAt(ref, true)
+	assert.Equal(t, "192001011234", v)
+
+	assert.Equal(t, 100, p.AgeAt(time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC)))
+}
+
+func TestKind(t *testing.T) {
+	tests := map[string]Kind{
+		"850709-9805": KindOrdinary,
+		"850769-9802": KindCoordination,
+		"850709-T224": KindInterim,
+		"850769-T221": KindInterimCoordination,
+	}
+
+	for in, expected := range tests {
+		p, err := Parse(in, &Options{AllowInterimNumber: true})
+		assert.Nil(t, err, in)
+		assert.Equal(t, expected, p.Kind(), in)
+	}
+}
+
+func TestSex(t *testing.T) {
+	p, _ := Parse("850709-9805")
+	assert.Equal(t, SexFemale, p.Sex())
+	assert.True(t, p.IsFemale())
+	assert.False(t, p.IsMale())
+
+	p, _ = Parse("850709-1232")
+	assert.Equal(t, SexMale, p.Sex())
+	assert.True(t, p.IsMale())
+	assert.False(t, p.IsFemale())
+
+	p, _ = Parse("850709-T224", &Options{AllowInterimNumber: true})
+	assert.Equal(t, SexUnknown, p.Sex())
+	assert.False(t, p.IsMale())
+	assert.False(t, p.IsFemale())
+
+	p, _ = Parse("850709-1232", &Options{DisableSexInference: true})
+	assert.Equal(t, SexUnknown, p.Sex())
+	assert.False(t, p.IsMale())
+	assert.False(t, p.IsFemale())
+}
+
+func TestZeroValue(t *testing.T) {
+	for _, p := range []*Personnummer{{}, {Num: "9"}, {Day: "7", Month: "1"}, {Century: "1"}, {Century: "19", Num: "12"}} {
+		assert.False(t, p.IsInterimNumber())
+		assert.False(t, p.IsCoordinationNumber())
+		assert.False(t, p.IsMale())
+		assert.False(t, p.IsFemale())
+		assert.Equal(t, SexUnknown, p.Sex())
+		assert.Equal(t, KindOrdinary, p.Kind())
+
+		p.Format()
+		p.Format(true)
+		p.FormatAt(time.Now())
+		p.FormatLayout("YYYYMMDDSNNNC")
+		p.Masked("")
+		assert.False(t, p.MatchesMasked("850709-****"))
+		assert.False(t, p.MatchesMasked("8507099805"))
+		assert.False(t, p.MatchesMasked("198507099805"))
+		assert.False(t, p.MatchesMasked("19850709-9805"))
+		p.GetDate()
+		p.GetAge()
+		p.AgeDetailsAt(time.Now())
+		p.NextBirthday(time.Now())
+		p.BirthCounty()
+		p.Uint64()
+		p.Key()
+		p.Equal(p)
+		p.VATNumber()
+		p.MarshalText()
+		p.MarshalBinary()
+		_ = p.String()
+		_ = fmt.Sprintf("%+v", p.Formatter())
+
+		assert.NotNil(t, p.Validate(nil))
+	}
+
+	var o Organisationsnummer
+	o.Format()
+	o.LegalForm()
+	o.VATNumber()
+}
+
+func TestValidate(t *testing.T) {
+	p, _ := Parse("850709-9805")
+	assert.Nil(t, p.Validate(nil))
+
+	p, _ = Parse("850769-9802")
+	assert.Nil(t, p.Validate(nil))
+	assert.True(t, errors.Is(p.Validate(&Options{DisableCoordinationNumber: true}), ErrCoordinationNumberNotAllowed))
+
+	p, _ = Parse("850709-T224", &Options{AllowInterimNumber: true})
+	assert.True(t, errors.Is(p.Validate(nil), ErrInterimNumberNotAllowed))
+	assert.Nil(t, p.Validate(&Options{AllowInterimNumber: true}))
+
+	valid := func() *Personnummer {
+		return &Personnummer{Century: "19", FullYear: "1985", Year: "85", Month: "07", Day: "09", Sep: "-", Num: "980", Check: "5"}
+	}
+	assert.Nil(t, valid().Validate(nil))
+
+	tests := []struct {
+		modify func(p *Personnummer)
+		err    error
+		offset int
+	}{
+		{func(p *Personnummer) { p.Century = "" }, ErrInvalidLength, -1},
+		{func(p *Personnummer) { p.Num = "98" }, ErrInvalidLength, -1},
+		{func(p *Personnummer) { p.Day = "0x" }, ErrInvalidCharacter, 7},
+		{func(p *Personnummer) { p.FullYear = "1885" }, ErrInvalidCentury, 0},
+		{func(p *Personnummer) { p.Num = "000" }, ErrZeroSerial, 8},
+		{func(p *Personnummer) { p.Month = "13" }, ErrInvalidMonth, 4},
+		{func(p *Personnummer) { p.Day = "32" }, ErrInvalidDay, 6},
+		{func(p *Personnummer) { p.Check = "6" }, ErrInvalidChecksum, 11},
+		{func(p *Personnummer) { p.Sep = "+" }, ErrInvalidSeparator, -1},
+		{func(p *Personnummer) { p.Sep = "" }, ErrInvalidSeparator, -1},
+	}
+
+	for _, test := range tests {
+		p := valid()
+		test.modify(p)
+
+		err := p.Validate(nil)
+		assert.True(t, errors.Is(err, test.err), test.err)
+
+		var perr *ParseError
+		assert.True(t, errors.As(err, &perr))
+		assert.Equal(t, test.offset, perr.Offset, test.err)
+	}
+
+	p = valid()
+	p.Century, p.FullYear, p.Sep = "18", "1885", "+"
+	assert.Nil(t, p.Validate(nil))
+	assert.True(t, errors.Is(p.Validate(&Options{ReferenceDate: time.Date(1980, 1, 1, 0, 0, 0, 0, time.UTC)}), ErrInvalidSeparator))
+}
+
+func TestStrict(t *testing.T) {
+	strict := &Options{Strict: true, ReferenceDate: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	for _, in := range []string{"850709-9805", "850709+9805", "198507099805", "19850709-9805", "18850709+9805"} {
+		assert.True(t, Valid(in, strict), in)
+		assert.True(t, ValidBytes([]byte(in), strict), in)
+	}
+
+	tests := []struct {
+		input  string
+		offset int
+	}{
+		{"8-5-0-7-0-9-9805", 1},
+		{"8507099805", 6},
+		{"19850709+9805", 8},
+		{"850709--9805", 7},
+		{"8507-09-9805", 4},
+		{"198507-099805", 6},
+		{"1985070998-05", 10},
+	}
+
+	for _, test := range tests {
+		assert.True(t, Valid(test.input), test.input)
+		assert.False(t, Valid(test.input, strict), test.input)
+
+		_, err := Parse(test.input, strict)
+		var pe *ParseError
+		assert.True(t, errors.As(err, &pe), test.input)
+		assert.Equal(t, ErrInvalidSeparator, pe.Err, test.input)
+		assert.Equal(t, test.offset, pe.Offset, test.input)
+	}
+
+	_, err := Parse("850709-9806", strict)
+	assert.True(t, errors.Is(err, ErrInvalidChecksum))
+
+	lenient := &Options{Strict: true, Lenient: true}
+	assert.True(t, Valid("pnr: 850709 – 9805", lenient))
+
+	_, err = Parse("pnr: 8507099805", lenient)
+	var pe *ParseError
+	assert.True(t, errors.As(err, &pe))
+	assert.Equal(t, ErrInvalidSeparator, pe.Err)
+	assert.Equal(t, 11, pe.Offset)
+}
@@ -0,0 +1,213 @@
+package personnummer
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// RedactMode represents how numbers are redacted.
+type RedactMode int
+
+const (
+	// RedactFull masks every digit.
+	RedactFull RedactMode = iota
+
+	// RedactKeepBirthDate masks the serial number and the check digit.
+	RedactKeepBirthDate
+
+	// RedactKeepLastFour masks the birth date.
+	RedactKeepLastFour
+
+	// RedactPseudonym replaces the number with another valid number
+	// derived from it and RedactOptions.Key, which must not be empty.
+	RedactPseudonym
+)
+
+// RedactOptions represents the redaction options.
+type RedactOptions struct {
+	// Mode is how numbers are redacted.
+	Mode RedactMode
+
+	// Mask is the character that replaces masked digits, '*' if zero.
+	Mask rune
+
+	// Key is the secret the pseudonyms are derived from. The same number
+	// and key always gives the same pseudonym. RedactPseudonym requires a
+	// key, numbers are masked like RedactFull without one since pseudonyms
+	// derived without a secret are reversed by trying every number.
+	Key []byte
+
+	// Options are used to find numbers, interim numbers are found if nil.
+	Options *Options
+}
+
+// Redact replaces every valid Swedish personal identity number in the text.
+// Separators and the number of digits are kept as written.
+func Redact(text string, opts *RedactOptions) string {
+	if opts == nil {
+		opts = &RedactOptions{}
+	}
+
+	var options []*Options
+	if opts.Options != nil {
+		options = append(options, opts.Options)
+	}
+
+	matches := FindAll(text, options...)
+	if len(matches) == 0 {
+		return text
+	}
+
+	var b strings.Builder
+	b.Grow(len(text))
+
+	last := 0
+	for _, m := range matches {
+		b.WriteString(text[last:m.Start])
+		b.WriteString(redactMatch(m, *opts))
+		last = m.End
+	}
+
+	b.WriteString(text[last:])
+
+	return b.String()
+}
+
+// redactMatch returns the redacted text of a match.
+func redactMatch(m Match, opts RedactOptions) string {
+	mask := opts.Mask
+	if mask == 0 {
+		mask = '*'
+	}
+
+	if opts.Mode == RedactPseudonym && len(opts.Key) == 0 {
+		opts.Mode = RedactFull
+	}
+
+	digits := len(m.Text) - strings.Count(m.Text, "-") - strings.Count(m.Text, "+")
+
+	var replacement string
+	if opts.Mode == RedactPseudonym {
+		replacement = pseudonym(m.Personnummer, opts.Key, digits == lengthWithCentury)
+	}
+
+	var b strings.Builder
+
+	i := 0
+	for _, c := range m.Text {
+		if c == '-' || c == '+' {
+			b.WriteRune(c)
+			continue
+		}
+
+		switch {
+		case opts.Mode == RedactPseudonym:
+			b.WriteByte(replacement[i])
+		case opts.Mode == RedactKeepBirthDate && i < digits-4:
+			b.WriteRune(c)
+		case opts.Mode == RedactKeepLastFour && i >= digits-4:
+			b.WriteRune(c)
+		default:
+			b.WriteRune(mask)
+		}
+
+		i++
+	}
+
+	return b.String()
+}
+
+// pseudonym returns the digits of a valid number derived from p and key,
+// with the same kind and separator as p.
+func pseudonym(p *Personnummer, key []byte, longFormat bool) string {
+	long, _ := p.Format(true)
+
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(long))
+	h := mac.Sum(nil)
+
+	ref := p.options.ReferenceDate
+	if ref.IsZero() {
+		ref = now()
+	}
+
+	year := ref.Year() - 1 - int(binary.BigEndian.Uint16(h[0:2])%99)
+	if p.Sep == "+" {
+		year = ref.Year() - 100 - int(binary.BigEndian.Uint16(h[0:2])%10)
+	}
+
+	month := time.Month(h[2]%12 + 1)
+	birth := time.Date(year, month, int(h[3])%daysIn(year, month)+1, 0, 0, 0, 0, time.UTC)
+
+	var num string
+	if p.IsInterimNumber() {
+		num = fmt.Sprintf("%c%02d", interimLetters[int(h[4])%len(interimLetters)], binary.BigEndian.Uint16(h[5:7])%100)
+	} else {
+		num = fmt.Sprintf("%03d", binary.BigEndian.Uint16(h[4:6])%999+1)
+	}
+
+	q := fromParts(birth, num, p.IsCoordinationNumber(), &Options{ReferenceDate: ref})
+	if longFormat {
+		v, _ := q.Format(true)
+		return v
+	}
+
+	return q.Year + q.Month + q.Day + q.Num + q.Check
+}
+
+// RedactWriter is an io.Writer that redacts Swedish personal identity numbers
+// before writing to the underlying writer. Text is written line by line, and
+// long lines in parts, call Close to write the final line.
+type RedactWriter struct {
+	w    io.Writer
+	opts RedactOptions
+	buf  bytes.Buffer
+}
+
+// NewRedactWriter returns a new RedactWriter writing to w.
+func NewRedactWriter(w io.Writer, opts *RedactOptions) *RedactWriter {
+	if opts == nil {
+		opts = &RedactOptions{}
+	}
+
+	return &RedactWriter{w: w, opts: *opts}
+}
+
+// Write redacts and writes all complete lines in p. Lines longer than
+// 64 KiB are written in parts, split between numbers like the Scanner.
+func (r *RedactWriter) Write(p []byte) (int, error) {
+	r.buf.Write(p)
+
+	n := bytes.LastIndexByte(r.buf.Bytes(), '\n') + 1
+	if n == 0 && r.buf.Len() >= maxLineLength {
+		n, _, _ = scanLines(r.buf.Bytes(), false)
+	}
+
+	if n == 0 {
+		return len(p), nil
+	}
+
+	if _, err := io.WriteString(r.w, Redact(string(r.buf.Next(n)), &r.opts)); err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
+
+// Close redacts and writes the remaining text. It does not close the underlying writer.
+func (r *RedactWriter) Close() error {
+	if r.buf.Len() == 0 {
+		return nil
+	}
+
+	_, err := io.WriteString(r.w, Redact(r.buf.String(), &r.opts))
+	r.buf.Reset()
+
+	return err
+}
@@ -0,0 +1,75 @@
+package personnummer
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/frozzare/go-assert"
+)
+
+func TestRedact(t *testing.T) {
+	text := "pnr 850709-9805, 198507699802 och 850709-T224."
+
+	assert.Equal(t, "pnr ******-****, ************ och ******-****.", Redact(text, &RedactOptions{}))
+	assert.Equal(t, "pnr XXXXXX-XXXX, XXXXXXXXXXXX och XXXXXX-XXXX.", Redact(text, &RedactOptions{Mask: 'X'}))
+	assert.Equal(t, "pnr 850709-****, 19850769**** och 850709-****.", Redact(text, &RedactOptions{Mode: RedactKeepBirthDate}))
+	assert.Equal(t, "pnr ******-9805, ********9802 och ******-T224.", Redact(text, &RedactOptions{Mode: RedactKeepLastFour}))
+	assert.Equal(t, "pnr ******-****, ************ och 850709-T224.", Redact(text, &RedactOptions{Options: &Options{}}))
+	assert.Equal(t, "inga nummer här", Redact("inga nummer här", nil))
+}
+
+func TestRedactEmbedded(t *testing.T) {
+	assert.Equal(t, "ref 1234**********", Redact("ref 12348507099805", &RedactOptions{}))
+}
+
+func TestRedactPseudonym(t *testing.T) {
+	opts := &RedactOptions{Mode: RedactPseudonym, Key: []byte("secret")}
+	text := "850709-9805 198507699802 850709-T224 850709+9805"
+
+	redacted := Redact(text, opts)
+	assert.Equal(t, redacted, Redact(text, opts))
+	assert.NotEqual(t, redacted, Redact(text, &RedactOptions{Mode: RedactPseudonym, Key: []byte("other")}))
+
+	matches := FindAll(redacted)
+	assert.Equal(t, 4, len(matches))
+
+	original := FindAll(text)
+	for i, m := range matches {
+		assert.NotEqual(t, original[i].Text, m.Text)
+		assert.Equal(t, len(original[i].Text), len(m.Text))
+		assert.Equal(t, original[i].Kind, m.Kind)
+		assert.Equal(t, original[i].Personnummer.Sep, m.Personnummer.Sep)
+	}
+}
+
+func TestRedactPseudonymWithoutKey(t *testing.T) {
+	assert.Equal(t, "******-****", Redact("850709-9805", &RedactOptions{Mode: RedactPseudonym}))
+	assert.Equal(t, "************", Redact("198507099805", &RedactOptions{Mode: RedactPseudonym, Key: []byte{}}))
+}
+
+func TestRedactWriter(t *testing.T) {
+	var b strings.Builder
+	w := NewRedactWriter(&b, &RedactOptions{})
+
+	w.Write([]byte("first 850709"))
+	w.Write([]byte("-9805\nsecond 8507"))
+	assert.Equal(t, "first ******-****\n", b.String())
+
+	w.Write([]byte("09-9805"))
+	assert.Nil(t, w.Close())
+	assert.Equal(t, "first ******-****\nsecond ******-****", b.String())
+}
+
+func TestRedactWriterLongLine(t *testing.T) {
+	var b strings.Builder
+	w := NewRedactWriter(&b, nil)
+
+	line := strings.Repeat("x ", maxLineLength/2) + "850709-9805"
+	_, err := w.Write([]byte(line))
+	assert.Nil(t, err)
+	assert.True(t, b.Len() > 0)
+	assert.True(t, len(line)-b.Len() <= maxMatchLength)
+
+	assert.Nil(t, w.Close())
+	assert.Equal(t, strings.Repeat("x ", maxLineLength/2)+"******-****", b.String())
+}
@@ -0,0 +1,318 @@
+package personnummer
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"strings"
+)
+
+const (
+	// keywordWindow is the number of bytes around a match searched for keywords.
+	keywordWindow = 32
+
+	// maxLineLength is the length after which the Scanner splits lines.
+	maxLineLength = 64 * 1024
+
+	// maxMatchLength is the length of the longest layout, YYYYMMDD-NNNC.
+	maxMatchLength = lengthWithCentury + 1
+
+	confidenceBase      = 0.5
+	confidenceSeparator = 0.2
+	confidenceCentury   = 0.1
+	confidenceKeyword   = 0.3
+	confidenceEmbedded  = 0.4
+)
+
+// keywords are words that, when found next to a number,
+// make it more likely to be a personal identity number.
+var keywords = []string{
+	"personnummer",
+	"personnr",
+	"pers.nr",
+	"pnr",
+	"samordningsnummer",
+	"personal identity number",
+}
+
+// Match represents a Swedish personal identity number found in a text.
+type Match struct {
+	// Start and End are the byte offsets of the match in the input, or in
+	// the stream for a Scanner, so input[Start:End] is Text.
+	Start int
+	End   int
+
+	// Text is the matched text as written.
+	Text string
+
+	// Personnummer is the parsed number.
+	Personnummer *Personnummer
+
+	// Kind is the kind of the number.
+	Kind Kind
+
+	// Confidence is a score between 0 and 1 of how likely
+	// the match is to be a personal identity number.
+	Confidence float64
+}
+
+// FindAll returns all valid Swedish personal identity numbers in the text.
+// Interim numbers are found unless options are given.
+func FindAll(text string, options ...*Options) []Match {
+	return findAll(text, "", scanOptions(options))
+}
+
+// scanOptions returns the options to scan with.
+func scanOptions(options []*Options) *Options {
+	if len(options) > 0 && options[0] != nil {
+		return options[0]
+	}
+
+	return &Options{AllowInterimNumber: true}
+}
+
+// findAll returns all matches in text, also within longer runs of digits.
+// The prefix is the text that came before it and is only used to search
+// for keywords.
+func findAll(text, prefix string, options *Options) []Match {
+	var matches []Match
+
+	for i := 0; i < len(text); i++ {
+		if !isDigit(text[i]) {
+			continue
+		}
+
+		m, ok := matchAt(text, i, options)
+		if !ok {
+			continue
+		}
+
+		m.Confidence = confidence(text, prefix, m)
+		matches = append(matches, m)
+		i = m.End - 1
+	}
+
+	return matches
+}
+
+// matchAt returns the match starting at the given offset, trying the long
+// format before the short format. Long formats born after the reference
+// date are skipped.
+func matchAt(text string, start int, options *Options) (Match, bool) {
+	for _, dateLength := range []int{8, 6} {
+		end, ok := candidateEnd(text, start, dateLength)
+		if !ok || !valid(text[start:end], options) {
+			continue
+		}
+
+		p, err := New(text[start:end], options)
+		if err != nil {
+			continue
+		}
+
+		// A long format with a date of birth in the future is more likely
+		// digits before a short format, as in a longer run of digits.
+		if dateLength == 8 && p.GetDate().After(options.referenceDate()) {
+			continue
+		}
+
+		return Match{
+			Start:        start,
+			End:          end,
+			Text:         text[start:end],
+			Personnummer: p,
+			Kind:         p.Kind(),
+		}, true
+	}
+
+	return Match{}, false
+}
+
+// candidateEnd returns the end of a number laid out as a date with the given
+// number of digits, an optional separator, a serial number and a check digit.
+func candidateEnd(text string, start, dateLength int) (int, bool) {
+	i := start
+	for ; i < start+dateLength; i++ {
+		if i >= len(text) || !isDigit(text[i]) {
+			return 0, false
+		}
+	}
+
+	if i < len(text) && (text[i] == '-' || text[i] == '+') {
+		i++
+	}
+
+	if i >= len(text) || !isDigit(text[i]) && !runeInSlice(rune(text[i]), interimLetters) {
+		return 0, false
+	}
+
+	for j := i + 1; j < i+4; j++ {
+		if j >= len(text) || !isDigit(text[j]) {
+			return 0, false
+		}
+	}
+
+	return i + 4, true
+}
+
+// confidence scores a match by its layout and surrounding text.
+func confidence(text, prefix string, m Match) float64 {
+	c := confidenceBase
+
+	separated := strings.ContainsAny(m.Text, "-+")
+	if separated {
+		c += confidenceSeparator
+	}
+
+	if len(m.Text) == lengthWithCentury || separated && len(m.Text) == lengthWithCentury+1 {
+		c += confidenceCentury
+	}
+
+	before := text[:m.Start]
+	if len(before) < keywordWindow {
+		before = prefix + before
+	}
+	if len(before) > keywordWindow {
+		before = before[len(before)-keywordWindow:]
+	}
+
+	after := text[m.End:]
+	if len(after) > keywordWindow {
+		after = after[:keywordWindow]
+	}
+
+	if hasKeyword(before) || hasKeyword(after) {
+		c += confidenceKeyword
+	}
+
+	if m.Start > 0 && isAlphanumeric(text[m.Start-1]) || m.End < len(text) && isAlphanumeric(text[m.End]) {
+		c -= confidenceEmbedded
+	}
+
+	if c < 0 {
+		return 0
+	}
+
+	if c > 1 {
+		return 1
+	}
+
+	return c
+}
+
+// hasKeyword checks if the text contains a keyword.
+func hasKeyword(text string) bool {
+	text = strings.ToLower(text)
+
+	for _, k := range keywords {
+		if strings.Contains(text, k) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isAlphanumeric(c byte) bool {
+	return isDigit(c) || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+// Scanner finds Swedish personal identity numbers in a stream of text.
+// Numbers are matched within lines, offsets are counted from the start of the stream.
+// Lines longer than 64 KiB are split, between numbers, to bound memory use.
+type Scanner struct {
+	s       *bufio.Scanner
+	options *Options
+	matches []Match
+	match   Match
+	offset  int
+	prefix  string
+	err     error
+	done    bool
+}
+
+// NewScanner returns a new Scanner to read from r.
+// Interim numbers are found unless options are given.
+func NewScanner(r io.Reader, options ...*Options) *Scanner {
+	s := bufio.NewScanner(r)
+	s.Buffer(nil, 2*maxLineLength)
+	s.Split(scanLines)
+
+	return &Scanner{
+		s:       s,
+		options: scanOptions(options),
+	}
+}
+
+// scanLines is a bufio.SplitFunc returning lines with the newline kept.
+// Lines longer than maxLineLength are split after the last character that
+// cannot be part of a number, so memory use stays bounded without newlines.
+func scanLines(data []byte, atEOF bool) (int, []byte, error) {
+	if i := bytes.IndexByte(data, '\n'); i >= 0 {
+		return i + 1, data[:i+1], nil
+	}
+
+	if atEOF && len(data) > 0 {
+		return len(data), data, nil
+	}
+
+	if len(data) < maxLineLength {
+		return 0, nil, nil
+	}
+
+	cut := len(data) - maxMatchLength
+	for i := len(data) - 1; i >= cut; i-- {
+		if c := data[i]; !isDigit(c) && c != '-' && c != '+' && !isInterimLetter(c) {
+			cut = i + 1
+			break
+		}
+	}
+
+	return cut, data[:cut], nil
+}
+
+// Scan advances the Scanner to the next match, which will then be available
+// through the Match method. It returns false when there are no more matches
+// or an error occurred.
+func (s *Scanner) Scan() bool {
+	for len(s.matches) == 0 {
+		if s.done || !s.s.Scan() {
+			s.done = true
+			s.err = s.s.Err()
+			return false
+		}
+
+		line := s.s.Text()
+
+		s.matches = findAll(line, s.prefix, s.options)
+		for i := range s.matches {
+			s.matches[i].Start += s.offset
+			s.matches[i].End += s.offset
+		}
+
+		s.offset += len(line)
+		s.prefix = line
+		if len(line) > keywordWindow {
+			s.prefix = line[len(line)-keywordWindow:]
+		}
+	}
+
+	s.match = s.matches[0]
+	s.matches = s.matches[1:]
+
+	return true
+}
+
+// Match returns the most recent match found by Scan.
+func (s *Scanner) Match() Match {
+	return s.match
+}
+
+// Err returns the first non-EOF error encountered by the Scanner.
+func (s *Scanner) Err() error {
+	return s.err
+}
@@ -0,0 +1,109 @@
+package personnummer
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/frozzare/go-assert"
+)
+
+func TestFindAll(t *testing.T) {
+	text := "Hej! Mitt personnummer är 850709-9805 och min dotters är 20040229-1231, samordningsnr 850769-9802."
+
+	matches := FindAll(text)
+	assert.Equal(t, 3, len(matches))
+
+	assert.Equal(t, "850709-9805", matches[0].Text)
+	assert.Equal(t, matches[0].Text, text[matches[0].Start:matches[0].End])
+	assert.Equal(t, KindOrdinary, matches[0].Kind)
+	assert.Equal(t, "1985", matches[0].Personnummer.FullYear)
+	assert.Equal(t, 1.0, matches[0].Confidence)
+
+	assert.Equal(t, "20040229-1231", matches[1].Text)
+	assert.Equal(t, KindOrdinary, matches[1].Kind)
+
+	assert.Equal(t, "850769-9802", matches[2].Text)
+	assert.Equal(t, KindCoordination, matches[2].Kind)
+}
+
+func TestFindAllInterim(t *testing.T) {
+	matches := FindAll("tillfälligt nummer 850709-T224")
+	assert.Equal(t, 1, len(matches))
+	assert.Equal(t, KindInterim, matches[0].Kind)
+
+	assert.Equal(t, 0, len(FindAll("tillfälligt nummer 850709-T224", &Options{})))
+	assert.Equal(t, 1, len(FindAll("tillfälligt nummer 850709-T224", nil)))
+
+	s := NewScanner(strings.NewReader("850709-T224"), nil)
+	assert.True(t, s.Scan())
+	assert.Equal(t, KindInterim, s.Match().Kind)
+}
+
+func TestFindAllConfidence(t *testing.T) {
+	bare := FindAll("order 8507099805 shipped")
+	assert.Equal(t, 1, len(bare))
+	assert.Equal(t, 0.5, bare[0].Confidence)
+
+	embedded := FindAll("ref 85070998051234")
+	assert.Equal(t, 1, len(embedded))
+	assert.Equal(t, "8507099805", embedded[0].Text)
+	assert.True(t, embedded[0].Confidence < bare[0].Confidence)
+
+	embedded = FindAll("ref 12348507099805")
+	assert.Equal(t, 1, len(embedded))
+	assert.Equal(t, "8507099805", embedded[0].Text)
+	assert.Equal(t, 8, embedded[0].Start)
+	assert.True(t, embedded[0].Confidence < bare[0].Confidence)
+
+	keyword := FindAll("pnr: 8507099805")
+	assert.Equal(t, 1, len(keyword))
+	assert.True(t, keyword[0].Confidence > bare[0].Confidence)
+
+	assert.Equal(t, 0, len(FindAll("8507099806 1234567890 19850709")))
+}
+
+func TestScanner(t *testing.T) {
+	text := "Personnummer:\n850709-9805\n\nfoo 198507699802 bar\n850709-1232"
+	s := NewScanner(strings.NewReader(text))
+
+	var matches []Match
+	for s.Scan() {
+		matches = append(matches, s.Match())
+	}
+
+	assert.Nil(t, s.Err())
+	assert.Equal(t, 3, len(matches))
+
+	for _, m := range matches {
+		assert.Equal(t, m.Text, text[m.Start:m.End])
+	}
+
+	assert.Equal(t, 1.0, matches[0].Confidence)
+	assert.Equal(t, KindCoordination, matches[1].Kind)
+	assert.Equal(t, "850709-1232", matches[2].Text)
+}
+
+func TestScannerLongLine(t *testing.T) {
+	var b strings.Builder
+	for b.Len() < 3*maxLineLength {
+		b.WriteString("foo 850709-9805 ")
+	}
+	b.WriteString("850709-1232 ")
+	b.WriteString(strings.Repeat("x", maxLineLength))
+	b.WriteString("850709-9805")
+	text := b.String()
+
+	s := NewScanner(strings.NewReader(text))
+
+	n := 0
+	var last Match
+	for s.Scan() {
+		last = s.Match()
+		assert.Equal(t, last.Text, text[last.Start:last.End])
+		n++
+	}
+
+	assert.Nil(t, s.Err())
+	assert.Equal(t, len(FindAll(text)), n)
+	assert.Equal(t, len(text), last.End)
+}
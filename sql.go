@@ -0,0 +1,74 @@
+package personnummer
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+)
+
+// Scan implements the sql.Scanner interface. String, []byte and int64 columns
+// are parsed like Parse without options, invalid numbers return a *ParseError.
+func (p *Personnummer) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case string:
+		return p.UnmarshalText([]byte(v))
+	case []byte:
+		return p.UnmarshalText(v)
+	case int64:
+		s := strconv.FormatInt(v, 10)
+		// Leading zeros are lost for short formats stored as integers.
+		for len(s) < lengthWithoutCentury {
+			s = "0" + s
+		}
+
+		return p.UnmarshalText([]byte(s))
+	case nil:
+		return fmt.Errorf("personnummer: cannot scan NULL into a personal identity number, use NullPersonnummer")
+	default:
+		return fmt.Errorf("personnummer: cannot scan %T into a personal identity number", src)
+	}
+}
+
+// Value implements the driver.Valuer interface.
+// The number is stored in the long format, YYYYMMDDNNNC. Numbers
+// without all fields set return a *ParseError.
+func (p Personnummer) Value() (driver.Value, error) {
+	v, err := p.longFormat()
+	if err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+// NullPersonnummer represents a personal identity number that may be null.
+type NullPersonnummer struct {
+	Personnummer Personnummer
+	Valid        bool
+}
+
+// Scan implements the sql.Scanner interface.
+func (n *NullPersonnummer) Scan(src interface{}) error {
+	if src == nil {
+		n.Personnummer, n.Valid = Personnummer{}, false
+		return nil
+	}
+
+	if err := n.Personnummer.Scan(src); err != nil {
+		n.Valid = false
+		return err
+	}
+
+	n.Valid = true
+
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+func (n NullPersonnummer) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+
+	return n.Personnummer.Value()
+}
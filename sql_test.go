@@ -0,0 +1,56 @@
+package personnummer
+
+import (
+	"database/sql/driver"
+	"errors"
+	"testing"
+
+	"github.com/frozzare/go-assert"
+)
+
+func TestScan(t *testing.T) {
+	for _, src := range []interface{}{"850709-9805", []byte("198507099805"), int64(198507099805), int64(8507099805)} {
+		var p Personnummer
+		assert.Nil(t, p.Scan(src), src)
+
+		v, err := p.Value()
+		assert.Nil(t, err)
+		assert.Equal(t, driver.Value("198507099805"), v)
+	}
+
+	var p Personnummer
+	assert.Nil(t, p.Scan(int64(1011220)))
+	assert.Equal(t, "2000", p.FullYear)
+
+	assert.True(t, errors.Is(p.Scan("850709-9806"), ErrInvalidChecksum))
+	assert.NotNil(t, p.Scan(nil))
+	assert.NotNil(t, p.Scan(1.5))
+}
+
+func TestValueZero(t *testing.T) {
+	_, err := Personnummer{}.Value()
+	assert.True(t, errors.Is(err, ErrInvalidLength))
+
+	_, err = NullPersonnummer{Valid: true}.Value()
+	assert.True(t, errors.Is(err, ErrInvalidLength))
+}
+
+func TestNullPersonnummer(t *testing.T) {
+	var n NullPersonnummer
+	assert.Nil(t, n.Scan(nil))
+	assert.False(t, n.Valid)
+
+	v, err := n.Value()
+	assert.Nil(t, err)
+	assert.Nil(t, v)
+
+	assert.Nil(t, n.Scan("850709-9805"))
+	assert.True(t, n.Valid)
+
+	v, _ = n.Value()
+	assert.Equal(t, driver.Value("198507099805"), v)
+
+	var perr *ParseError
+	assert.True(t, errors.As(n.Scan("850709-9806"), &perr))
+	assert.False(t, n.Valid)
+}
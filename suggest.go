@@ -0,0 +1,166 @@
+package personnummer
+
+import "sort"
+
+// Edit represents the kind of correction of a suggestion.
+type Edit int
+
+const (
+	// EditOCR replaces a character that is commonly confused with a digit by OCR.
+	EditOCR Edit = iota
+
+	// EditTransposition swaps two adjacent digits.
+	EditTransposition
+
+	// EditSubstitution replaces a digit with another digit.
+	EditSubstitution
+)
+
+// String returns the name of the edit.
+func (e Edit) String() string {
+	switch e {
+	case EditOCR:
+		return "ocr"
+	case EditTransposition:
+		return "transposition"
+	case EditSubstitution:
+		return "substitution"
+	default:
+		return "unknown"
+	}
+}
+
+// editScores is how plausible each kind of edit is.
+var editScores = map[Edit]float64{
+	EditOCR:           0.9,
+	EditTransposition: 0.7,
+	EditSubstitution:  0.5,
+}
+
+// ocrDigits maps characters commonly confused with digits by OCR to the digit.
+var ocrDigits = map[byte]byte{
+	'O': '0',
+	'o': '0',
+	'D': '0',
+	'l': '1',
+	'I': '1',
+	'i': '1',
+	'|': '1',
+	'Z': '2',
+	'S': '5',
+	's': '5',
+	'G': '6',
+	'B': '8',
+}
+
+// Suggestion represents a likely correction of an invalid number.
+type Suggestion struct {
+	// Text is the corrected input.
+	Text string
+
+	// Personnummer is the parsed correction.
+	Personnummer *Personnummer
+
+	// Edit is the kind of correction.
+	Edit Edit
+
+	// Position is the byte offset in the input of the first changed character.
+	Position int
+
+	// Score is a value between 0 and 1 of how plausible the correction is.
+	Score float64
+}
+
+// Suggest returns up to max valid corrections of a Swedish personal identity
+// number that does not validate, the most plausible first. All corrections are
+// returned if max is zero or less. Returns nil if the input is valid.
+func Suggest(input string, max int, options ...*Options) []Suggestion {
+	if Valid(input, options...) {
+		return nil
+	}
+
+	var suggestions []Suggestion
+	seen := map[string]bool{}
+
+	try := func(b []byte, edit Edit, position int) {
+		text := string(b)
+		if seen[text] {
+			return
+		}
+
+		seen[text] = true
+
+		p, err := Parse(text, options...)
+		if err != nil {
+			return
+		}
+
+		suggestions = append(suggestions, Suggestion{
+			Text:         text,
+			Personnummer: p,
+			Edit:         edit,
+			Position:     position,
+			Score:        editScores[edit],
+		})
+	}
+
+	// All OCR confusions at once, as they tend to repeat, then one by one.
+	all := []byte(input)
+	first := -1
+	for i, c := range all {
+		if d, ok := ocrDigits[c]; ok {
+			all[i] = d
+			if first < 0 {
+				first = i
+			}
+		}
+	}
+
+	if first >= 0 {
+		try(all, EditOCR, first)
+	}
+
+	for i := 0; i < len(input); i++ {
+		if d, ok := ocrDigits[input[i]]; ok {
+			b := []byte(input)
+			b[i] = d
+			try(b, EditOCR, i)
+		}
+	}
+
+	for i := 0; i+1 < len(input); i++ {
+		if !isDigit(input[i]) || !isDigit(input[i+1]) || input[i] == input[i+1] {
+			continue
+		}
+
+		b := []byte(input)
+		b[i], b[i+1] = b[i+1], b[i]
+		try(b, EditTransposition, i)
+	}
+
+	for i := 0; i < len(input); i++ {
+		if !isDigit(input[i]) {
+			continue
+		}
+
+		for d := byte('0'); d <= '9'; d++ {
+			if d == input[i] {
+				continue
+			}
+
+			b := []byte(input)
+			b[i] = d
+			try(b, EditSubstitution, i)
+		}
+	}
+
+	sort.SliceStable(suggestions, func(i, j int) bool {
+		return suggestions[i].Score > suggestions[j].Score
+	})
+
+	if max > 0 && len(suggestions) > max {
+		suggestions = suggestions[:max]
+	}
+
+	return suggestions
+}
@@ -0,0 +1,47 @@
+package personnummer
+
+import (
+	"testing"
+
+	"github.com/frozzare/go-assert"
+)
+
+func TestSuggest(t *testing.T) {
+	assert.Nil(t, Suggest("850709-9805", 5))
+
+	s := Suggest("85O7O9-98O5", 5)
+	assert.True(t, len(s) > 0)
+	assert.Equal(t, "850709-9805", s[0].Text)
+	assert.Equal(t, EditOCR, s[0].Edit)
+	assert.Equal(t, 2, s[0].Position)
+
+	s = Suggest("850709-98O5", 0)
+	assert.Equal(t, "850709-9805", s[0].Text)
+	assert.Equal(t, 9, s[0].Position)
+
+	s = Suggest("850709-9850", 0)
+	assert.Equal(t, "850709-9805", s[0].Text)
+	assert.Equal(t, EditTransposition, s[0].Edit)
+	assert.Equal(t, 9, s[0].Position)
+
+	s = Suggest("850709-9806", 0)
+	assert.True(t, len(s) > 1)
+	for i, v := range s {
+		assert.True(t, Valid(v.Text), v.Text)
+		if i > 0 {
+			assert.True(t, s[i-1].Score >= v.Score)
+		}
+	}
+
+	found := false
+	for _, v := range s {
+		if v.Text == "850709-9805" {
+			found = true
+			assert.Equal(t, EditSubstitution, v.Edit)
+			assert.Equal(t, 10, v.Position)
+		}
+	}
+	assert.True(t, found)
+
+	assert.Equal(t, 3, len(Suggest("850709-9806", 3)))
+}
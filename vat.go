@@ -0,0 +1,100 @@
+package personnummer
+
+import (
+	"errors"
+	"strings"
+)
+
+const (
+	vatCountryCode = "SE"
+	vatSuffix      = "01"
+	vatLength      = len(vatCountryCode) + lengthWithoutCentury + len(vatSuffix)
+)
+
+// VATNumber returns the Swedish VAT number (momsregistreringsnummer)
+// of a sole trader with the personal identity number. Interim numbers
+// have no VAT number.
+func (p *Personnummer) VATNumber() (string, error) {
+	if p.IsInterimNumber() {
+		return "", ErrInterimNumberNotAllowed
+	}
+
+	number := p.Year + p.Month + p.Day + p.Num + p.Check
+	if len(number) != lengthWithoutCentury {
+		return "", ErrInvalidLength
+	}
+
+	return vatCountryCode + number + vatSuffix, nil
+}
+
+// VATNumber returns the Swedish VAT number (momsregistreringsnummer) of the organisation.
+func (o *Organisationsnummer) VATNumber() (string, error) {
+	if o.personnummer != nil {
+		return o.personnummer.VATNumber()
+	}
+
+	if len(o.Number) != lengthWithoutCentury {
+		return "", ErrInvalidLength
+	}
+
+	return vatCountryCode + o.Number + vatSuffix, nil
+}
+
+// VATNumber returns the Swedish VAT number (momsregistreringsnummer) of the number.
+func (a *AnyNumber) VATNumber() (string, error) {
+	switch {
+	case a.Type == NumberTypePersonnummer && a.Personnummer != nil:
+		return a.Personnummer.VATNumber()
+	case a.Type == NumberTypeOrganisationsnummer && a.Organisationsnummer != nil:
+		return a.Organisationsnummer.VATNumber()
+	default:
+		return "", ErrInvalidVATNumber
+	}
+}
+
+// ParseVATNumber parses a Swedish VAT number, SE followed by 10 digits and 01,
+// and returns the personal identity number or organisation number in it.
+// The country code is case insensitive.
+func ParseVATNumber(in string, options ...*Options) (*AnyNumber, error) {
+	if len(in) != vatLength {
+		return nil, &ParseError{Input: in, Offset: -1, Err: ErrInvalidVATNumber, subject: subjectVATNumber}
+	}
+
+	if !strings.EqualFold(in[:len(vatCountryCode)], vatCountryCode) {
+		return nil, &ParseError{Input: in, Offset: 0, Err: ErrInvalidVATNumber, subject: subjectVATNumber}
+	}
+
+	if !strings.HasSuffix(in, vatSuffix) {
+		return nil, &ParseError{Input: in, Offset: vatLength - len(vatSuffix), Err: ErrInvalidVATNumber, subject: subjectVATNumber}
+	}
+
+	number := in[len(vatCountryCode) : vatLength-len(vatSuffix)]
+	for i := 0; i < len(number); i++ {
+		if !isDigit(number[i]) {
+			return nil, &ParseError{Input: in, Offset: len(vatCountryCode) + i, Err: ErrInvalidCharacter, subject: subjectVATNumber}
+		}
+	}
+
+	a, err := ParseAny(number, options...)
+	if err != nil {
+		var perr *ParseError
+		if errors.As(err, &perr) {
+			offset := perr.Offset
+			if offset >= 0 {
+				offset += len(vatCountryCode)
+			}
+
+			return nil, &ParseError{Input: in, Offset: offset, Err: perr.Err, subject: subjectVATNumber}
+		}
+
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// ValidVATNumber will validate Swedish VAT numbers.
+func ValidVATNumber(in string, options ...*Options) bool {
+	_, err := ParseVATNumber(in, options...)
+	return err == nil
+}
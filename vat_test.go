@@ -0,0 +1,90 @@
+package personnummer
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/frozzare/go-assert"
+)
+
+func TestVATNumber(t *testing.T) {
+	p, _ := Parse("19850709-9805")
+	v, err := p.VATNumber()
+	assert.Nil(t, err)
+	assert.Equal(t, "SE850709980501", v)
+
+	o, _ := ParseOrganisationsnummer("556016-0680")
+	v, err = o.VATNumber()
+	assert.Nil(t, err)
+	assert.Equal(t, "SE556016068001", v)
+
+	a, _ := ParseAny("16556016-0680")
+	v, err = a.VATNumber()
+	assert.Nil(t, err)
+	assert.Equal(t, "SE556016068001", v)
+
+	a, _ = ParseAny("850709-9805")
+	v, err = a.VATNumber()
+	assert.Nil(t, err)
+	assert.Equal(t, "SE850709980501", v)
+}
+
+func TestVATNumberErrors(t *testing.T) {
+	p, _ := Parse("850709-T224", &Options{AllowInterimNumber: true})
+	_, err := p.VATNumber()
+	assert.Equal(t, ErrInterimNumberNotAllowed, err)
+
+	o, _ := ParseOrganisationsnummer("850709-T224", &Options{AllowInterimNumber: true})
+	_, err = o.VATNumber()
+	assert.Equal(t, ErrInterimNumberNotAllowed, err)
+
+	_, err = (&Personnummer{}).VATNumber()
+	assert.Equal(t, ErrInvalidLength, err)
+
+	_, err = (&AnyNumber{}).VATNumber()
+	assert.Equal(t, ErrInvalidVATNumber, err)
+
+	_, err = (&AnyNumber{Type: NumberTypeOrganisationsnummer}).VATNumber()
+	assert.Equal(t, ErrInvalidVATNumber, err)
+}
+
+func TestParseVATNumber(t *testing.T) {
+	a, err := ParseVATNumber("SE556016068001")
+	assert.Nil(t, err)
+	assert.Equal(t, NumberTypeOrganisationsnummer, a.Type)
+	assert.Equal(t, "5560160680", a.Organisationsnummer.Number)
+
+	a, err = ParseVATNumber("se850709980501")
+	assert.Nil(t, err)
+	assert.Equal(t, NumberTypePersonnummer, a.Type)
+	assert.Equal(t, "1985", a.Personnummer.FullYear)
+
+	assert.True(t, ValidVATNumber("SE556016068001"))
+}
+
+func TestParseVATNumberErrors(t *testing.T) {
+	tests := []struct {
+		in     string
+		err    error
+		offset int
+	}{
+		{"SE55601606800", ErrInvalidVATNumber, -1},
+		{"DK556016068001", ErrInvalidVATNumber, 0},
+		{"SE556016068002", ErrInvalidVATNumber, 12},
+		{"SE55601606a001", ErrInvalidCharacter, 10},
+		{"SE556016068101", ErrInvalidChecksum, 11},
+		{"SE850709980601", ErrInvalidChecksum, 11},
+		{"SE851309980501", ErrInvalidMonth, 4},
+	}
+
+	for _, test := range tests {
+		_, err := ParseVATNumber(test.in)
+		assert.True(t, errors.Is(err, test.err), test.in)
+
+		var perr *ParseError
+		assert.True(t, errors.As(err, &perr), test.in)
+		assert.Equal(t, test.in, perr.Input)
+		assert.Equal(t, test.offset, perr.Offset, test.in)
+		assert.False(t, ValidVATNumber(test.in), test.in)
+	}
+}